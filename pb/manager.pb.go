@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // source: manager.proto
 
-// protoc --go_out=plugins=grpc:. *.proto && sed -i "" -e "s///g" ./*.go
+// Minter node manager
 
 package pb
 
@@ -946,6 +946,45 @@ func (m *StatusResponse_TmStatus_ValidatorInfo_PubKey) GetValue() string {
 	return ""
 }
 
+type WatchStatusRequest struct {
+	Interval             int64    `protobuf:"varint,1,opt,name=interval,proto3" json:"interval"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchStatusRequest) Reset()         { *m = WatchStatusRequest{} }
+func (m *WatchStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchStatusRequest) ProtoMessage()    {}
+func (*WatchStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{3}
+}
+
+func (m *WatchStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchStatusRequest.Unmarshal(m, b)
+}
+func (m *WatchStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchStatusRequest.Marshal(b, m, deterministic)
+}
+func (m *WatchStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchStatusRequest.Merge(m, src)
+}
+func (m *WatchStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchStatusRequest.Size(m)
+}
+func (m *WatchStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchStatusRequest proto.InternalMessageInfo
+
+func (m *WatchStatusRequest) GetInterval() int64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
 type PruneBlocksRequest struct {
 	FromHeight           int64    `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height"`
 	ToHeight             int64    `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height"`
@@ -958,7 +997,7 @@ func (m *PruneBlocksRequest) Reset()         { *m = PruneBlocksRequest{} }
 func (m *PruneBlocksRequest) String() string { return proto.CompactTextString(m) }
 func (*PruneBlocksRequest) ProtoMessage()    {}
 func (*PruneBlocksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cde9ec64f0d2c859, []int{3}
+	return fileDescriptor_cde9ec64f0d2c859, []int{4}
 }
 
 func (m *PruneBlocksRequest) XXX_Unmarshal(b []byte) error {
@@ -993,6 +1032,77 @@ func (m *PruneBlocksRequest) GetToHeight() int64 {
 	return 0
 }
 
+type PruneBlocksResponse struct {
+	Total                int64    `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Current              int64    `protobuf:"varint,2,opt,name=current,proto3" json:"current"`
+	Height               int64    `protobuf:"varint,3,opt,name=height,proto3" json:"height"`
+	BytesReclaimed       int64    `protobuf:"varint,4,opt,name=bytes_reclaimed,json=bytesReclaimed,proto3" json:"bytes_reclaimed"`
+	Eta                  int64    `protobuf:"varint,5,opt,name=eta,proto3" json:"eta"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PruneBlocksResponse) Reset()         { *m = PruneBlocksResponse{} }
+func (m *PruneBlocksResponse) String() string { return proto.CompactTextString(m) }
+func (*PruneBlocksResponse) ProtoMessage()    {}
+func (*PruneBlocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{5}
+}
+
+func (m *PruneBlocksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PruneBlocksResponse.Unmarshal(m, b)
+}
+func (m *PruneBlocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PruneBlocksResponse.Marshal(b, m, deterministic)
+}
+func (m *PruneBlocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PruneBlocksResponse.Merge(m, src)
+}
+func (m *PruneBlocksResponse) XXX_Size() int {
+	return xxx_messageInfo_PruneBlocksResponse.Size(m)
+}
+func (m *PruneBlocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PruneBlocksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PruneBlocksResponse proto.InternalMessageInfo
+
+func (m *PruneBlocksResponse) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *PruneBlocksResponse) GetCurrent() int64 {
+	if m != nil {
+		return m.Current
+	}
+	return 0
+}
+
+func (m *PruneBlocksResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PruneBlocksResponse) GetBytesReclaimed() int64 {
+	if m != nil {
+		return m.BytesReclaimed
+	}
+	return 0
+}
+
+func (m *PruneBlocksResponse) GetEta() int64 {
+	if m != nil {
+		return m.Eta
+	}
+	return 0
+}
+
 type DealPeerRequest struct {
 	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address"`
 	Persistent           bool     `protobuf:"varint,2,opt,name=persistent,proto3" json:"persistent"`
@@ -1005,7 +1115,7 @@ func (m *DealPeerRequest) Reset()         { *m = DealPeerRequest{} }
 func (m *DealPeerRequest) String() string { return proto.CompactTextString(m) }
 func (*DealPeerRequest) ProtoMessage()    {}
 func (*DealPeerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cde9ec64f0d2c859, []int{4}
+	return fileDescriptor_cde9ec64f0d2c859, []int{6}
 }
 
 func (m *DealPeerRequest) XXX_Unmarshal(b []byte) error {
@@ -1040,267 +1150,3635 @@ func (m *DealPeerRequest) GetPersistent() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*NodeInfo)(nil), "pb.NodeInfo")
-	proto.RegisterType((*NodeInfo_ProtocolVersion)(nil), "pb.NodeInfo.ProtocolVersion")
-	proto.RegisterType((*NodeInfo_Other)(nil), "pb.NodeInfo.Other")
-	proto.RegisterType((*NetInfoResponse)(nil), "pb.NetInfoResponse")
-	proto.RegisterType((*NetInfoResponse_Peer)(nil), "pb.NetInfoResponse.Peer")
-	proto.RegisterType((*NetInfoResponse_Peer_ConnectionStatus)(nil), "pb.NetInfoResponse.Peer.ConnectionStatus")
-	proto.RegisterType((*NetInfoResponse_Peer_ConnectionStatus_Monitor)(nil), "pb.NetInfoResponse.Peer.ConnectionStatus.Monitor")
-	proto.RegisterType((*NetInfoResponse_Peer_ConnectionStatus_Channel)(nil), "pb.NetInfoResponse.Peer.ConnectionStatus.Channel")
-	proto.RegisterType((*StatusResponse)(nil), "pb.StatusResponse")
-	proto.RegisterType((*StatusResponse_TmStatus)(nil), "pb.StatusResponse.TmStatus")
-	proto.RegisterType((*StatusResponse_TmStatus_SyncInfo)(nil), "pb.StatusResponse.TmStatus.SyncInfo")
-	proto.RegisterType((*StatusResponse_TmStatus_ValidatorInfo)(nil), "pb.StatusResponse.TmStatus.ValidatorInfo")
-	proto.RegisterType((*StatusResponse_TmStatus_ValidatorInfo_PubKey)(nil), "pb.StatusResponse.TmStatus.ValidatorInfo.PubKey")
-	proto.RegisterType((*PruneBlocksRequest)(nil), "pb.PruneBlocksRequest")
-	proto.RegisterType((*DealPeerRequest)(nil), "pb.DealPeerRequest")
+type StopPeerRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("manager.proto", fileDescriptor_cde9ec64f0d2c859) }
+func (m *StopPeerRequest) Reset()         { *m = StopPeerRequest{} }
+func (m *StopPeerRequest) String() string { return proto.CompactTextString(m) }
+func (*StopPeerRequest) ProtoMessage()    {}
+func (*StopPeerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{7}
+}
 
-var fileDescriptor_cde9ec64f0d2c859 = []byte{
-	// 1235 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcd, 0x8e, 0x1b, 0x45,
-	0x17, 0x95, 0xdd, 0xfe, 0x69, 0x5f, 0x8f, 0xe7, 0xa7, 0xf2, 0x29, 0x9f, 0xe9, 0x89, 0xc8, 0x30,
-	0x8a, 0x90, 0x83, 0x50, 0x07, 0x0c, 0x02, 0x36, 0x2c, 0x26, 0x33, 0x08, 0xac, 0x90, 0xc4, 0x94,
-	0x43, 0xb6, 0xad, 0x72, 0xfb, 0x8e, 0xdd, 0x9a, 0x76, 0x55, 0xd1, 0x5d, 0xed, 0xc4, 0x3c, 0x06,
-	0x8f, 0x81, 0x84, 0x58, 0xf2, 0x10, 0xac, 0x59, 0xf1, 0x1e, 0x91, 0xd8, 0xa1, 0xfa, 0x69, 0xc7,
-	0x3d, 0x93, 0x89, 0x32, 0xac, 0x5c, 0xe7, 0xdc, 0xba, 0xc7, 0xb7, 0x4e, 0x55, 0xdd, 0x2e, 0xe8,
-	0x2d, 0x19, 0x67, 0x73, 0xcc, 0x42, 0x99, 0x09, 0x25, 0x48, 0x5d, 0x4e, 0x83, 0xc3, 0xb9, 0x10,
-	0xf3, 0x14, 0x1f, 0x18, 0x66, 0x5a, 0x9c, 0x3f, 0xc0, 0xa5, 0x54, 0x6b, 0x3b, 0xe1, 0xf8, 0x57,
-	0x0f, 0xfc, 0x27, 0x62, 0x86, 0x23, 0x7e, 0x2e, 0xc8, 0xb7, 0xb0, 0x6f, 0xd8, 0x58, 0xa4, 0xd1,
-	0x0a, 0xb3, 0x3c, 0x11, 0xbc, 0xef, 0x1f, 0xd5, 0x06, 0xdd, 0xe1, 0x9d, 0x50, 0x4e, 0xc3, 0x72,
-	0x5e, 0x38, 0x76, 0x93, 0x9e, 0xdb, 0x39, 0x74, 0x4f, 0x56, 0x09, 0xb2, 0x0b, 0xf5, 0x64, 0xd6,
-	0xaf, 0x1d, 0xd5, 0x06, 0x1d, 0x5a, 0x4f, 0x66, 0xe4, 0x2e, 0x74, 0xd3, 0x24, 0x57, 0xc8, 0x23,
-	0x36, 0x9b, 0x65, 0xfd, 0xba, 0x09, 0x80, 0xa5, 0x4e, 0x66, 0xb3, 0x8c, 0xf4, 0xa1, 0xcd, 0x51,
-	0xbd, 0x10, 0xd9, 0x45, 0xdf, 0x33, 0xc1, 0x12, 0xea, 0x48, 0x59, 0x4a, 0xc3, 0x46, 0x1c, 0x24,
-	0x01, 0xf8, 0xf1, 0x82, 0x71, 0x8e, 0x69, 0xde, 0x6f, 0x9a, 0xd0, 0x06, 0xeb, 0xac, 0xa5, 0xe0,
-	0xc9, 0x05, 0x66, 0xfd, 0x96, 0xcd, 0x72, 0x90, 0x0c, 0xa0, 0x29, 0xd4, 0x02, 0xb3, 0x7e, 0xdb,
-	0x2c, 0x8c, 0x54, 0x16, 0xf6, 0x54, 0x47, 0xa8, 0x9d, 0x10, 0x3c, 0x82, 0xbd, 0x4b, 0x0b, 0x25,
-	0xfb, 0xe0, 0xc9, 0xa1, 0x34, 0x25, 0x36, 0xa8, 0x1e, 0x92, 0xff, 0x41, 0x73, 0x9a, 0x8a, 0xf8,
-	0xc2, 0x2c, 0xb6, 0x41, 0x2d, 0xd0, 0xf3, 0x98, 0x94, 0x66, 0x9d, 0x0d, 0xaa, 0x87, 0xc1, 0x29,
-	0x34, 0x8d, 0x38, 0x79, 0x0f, 0x7c, 0xf5, 0x32, 0x4a, 0xf8, 0x0c, 0x5f, 0x3a, 0x1f, 0xda, 0xea,
-	0xe5, 0x48, 0x43, 0xed, 0x52, 0x26, 0x63, 0x63, 0x11, 0xe6, 0xb9, 0xb3, 0x0f, 0x32, 0x19, 0x9f,
-	0x58, 0xe6, 0xf8, 0x97, 0x0e, 0xec, 0x3d, 0x41, 0xa5, 0x4b, 0xa5, 0x98, 0x4b, 0xc1, 0x73, 0x24,
-	0x77, 0xa0, 0x63, 0x7d, 0x4c, 0xf8, 0xdc, 0x38, 0xe4, 0xd3, 0xd7, 0xc4, 0xeb, 0x28, 0x66, 0x5a,
-	0xd0, 0x1b, 0x74, 0xe8, 0x6b, 0x82, 0xfc, 0x1f, 0xda, 0x3c, 0x92, 0xa8, 0x63, 0xba, 0x14, 0x8f,
-	0xb6, 0xf8, 0x58, 0x23, 0x12, 0x42, 0xd3, 0xd2, 0xde, 0x91, 0x37, 0xe8, 0x0e, 0xfb, 0xc6, 0xa4,
-	0xea, 0x1f, 0x87, 0x7a, 0x26, 0xb5, 0xd3, 0x82, 0x7f, 0xda, 0xd0, 0xd0, 0x98, 0xdc, 0x87, 0x0e,
-	0x17, 0x33, 0x8c, 0x12, 0x7e, 0x2e, 0x4c, 0x35, 0xdd, 0xe1, 0xce, 0xb6, 0xc3, 0xd4, 0xe7, 0xe5,
-	0x61, 0xbb, 0x0b, 0xdd, 0x24, 0x8f, 0x44, 0xa1, 0xa6, 0xa2, 0xe0, 0xf6, 0xb0, 0xf8, 0x14, 0x92,
-	0xfc, 0xa9, 0x63, 0xc8, 0x73, 0x38, 0x88, 0x05, 0xe7, 0x18, 0xab, 0x44, 0xf0, 0x28, 0x57, 0x4c,
-	0x15, 0xb6, 0xce, 0xee, 0xf0, 0xfe, 0x75, 0x05, 0x85, 0xa7, 0x9b, 0x8c, 0x89, 0x49, 0xa0, 0xfb,
-	0xf1, 0x25, 0x86, 0x1c, 0x42, 0x27, 0xc3, 0xa5, 0x50, 0x18, 0x25, 0xd2, 0x9d, 0x36, 0xdf, 0x12,
-	0x23, 0x19, 0xfc, 0xde, 0x82, 0xfd, 0xcb, 0x1a, 0xfa, 0xa4, 0x9d, 0x15, 0x19, 0x53, 0xe5, 0x21,
-	0xf4, 0xe8, 0x06, 0x93, 0x09, 0x74, 0x27, 0xc8, 0x67, 0x8f, 0x05, 0x4f, 0x94, 0xc8, 0xcc, 0x32,
-	0xba, 0xc3, 0x4f, 0xdf, 0xb9, 0xbe, 0xd0, 0x25, 0xd2, 0x6d, 0x15, 0x2d, 0x4a, 0x31, 0x5e, 0x95,
-	0xa2, 0xf5, 0xff, 0x2c, 0xba, 0xa5, 0x42, 0x1e, 0x83, 0x7f, 0x5a, 0xde, 0x17, 0xbb, 0xaf, 0x37,
-	0x50, 0x74, 0x99, 0x74, 0x23, 0x11, 0xfc, 0x55, 0x87, 0x76, 0x29, 0x7d, 0x1b, 0x5a, 0x27, 0xb1,
-	0x4a, 0x56, 0xd8, 0xef, 0x99, 0x6d, 0x74, 0x48, 0xdf, 0x8e, 0x89, 0x62, 0x99, 0x72, 0x67, 0xd9,
-	0x82, 0x8a, 0x9d, 0xf5, 0x4b, 0x76, 0x12, 0x68, 0x8c, 0x66, 0x29, 0x9a, 0x7d, 0xf1, 0xa8, 0x19,
-	0x6b, 0x95, 0x87, 0x6b, 0x85, 0xb9, 0xf3, 0xde, 0x02, 0x7d, 0xc5, 0x27, 0x6c, 0x29, 0x53, 0xb4,
-	0xb7, 0xdf, 0xa3, 0x25, 0xd4, 0xfa, 0x23, 0x9e, 0x2b, 0xca, 0x14, 0x9a, 0xdb, 0xef, 0xd1, 0x0d,
-	0xd6, 0x59, 0xa7, 0x45, 0x66, 0x42, 0x6d, 0x9b, 0xe5, 0xa0, 0x8e, 0x9c, 0xac, 0xe6, 0x26, 0xe2,
-	0xdb, 0x88, 0x83, 0x5a, 0x6f, 0x8c, 0xec, 0xc2, 0x84, 0x3a, 0x56, 0xaf, 0xc4, 0x3a, 0x66, 0xca,
-	0xa1, 0xb8, 0xec, 0x83, 0x8d, 0x95, 0x58, 0x2b, 0x3e, 0x4b, 0x96, 0xa8, 0x43, 0x5d, 0xab, 0xe8,
-	0xa0, 0x51, 0xcc, 0xc4, 0xdc, 0x5c, 0xf3, 0x9d, 0xa3, 0xda, 0xa0, 0x47, 0x37, 0x38, 0xf8, 0xad,
-	0x06, 0x6d, 0x67, 0xb2, 0xee, 0xa3, 0xa3, 0x33, 0xb3, 0xbc, 0x26, 0xad, 0x8f, 0xce, 0xc8, 0xc7,
-	0x70, 0xa0, 0x8f, 0xc9, 0x0f, 0x05, 0x16, 0x78, 0xca, 0x24, 0x8b, 0x13, 0xb5, 0x36, 0xde, 0x7a,
-	0xf4, 0x6a, 0x80, 0xdc, 0x83, 0xde, 0x86, 0x9c, 0x24, 0x3f, 0xa3, 0x33, 0xbb, 0x4a, 0xda, 0x5a,
-	0x12, 0x91, 0x69, 0x29, 0xcf, 0xad, 0xce, 0x61, 0x72, 0x0c, 0x3b, 0x14, 0x63, 0xe4, 0x2a, 0x5d,
-	0x4f, 0x90, 0x2b, 0xb7, 0x01, 0x15, 0xee, 0xf8, 0x8f, 0x36, 0xec, 0xba, 0xbb, 0x56, 0xf6, 0xa4,
-	0xad, 0x9e, 0xdd, 0xae, 0xf6, 0xec, 0x8f, 0xe0, 0x20, 0x65, 0x0a, 0x73, 0x15, 0x99, 0x46, 0x19,
-	0x2d, 0x58, 0xbe, 0x70, 0x87, 0x63, 0xcf, 0x06, 0x1e, 0x6a, 0xfe, 0x3b, 0x96, 0x2f, 0xc8, 0x87,
-	0xe0, 0xa8, 0x88, 0x49, 0x69, 0x67, 0xda, 0x86, 0xd9, 0xb3, 0xf4, 0x89, 0x94, 0x66, 0x5e, 0x08,
-	0xb7, 0xaa, 0x9a, 0x98, 0xcc, 0x17, 0xca, 0xad, 0xe5, 0x60, 0x5b, 0xd5, 0x04, 0xae, 0xd4, 0xa0,
-	0x92, 0x25, 0xba, 0x6f, 0xcb, 0x76, 0x0d, 0x7a, 0xaf, 0xc8, 0x00, 0xf6, 0x2f, 0x10, 0x65, 0x94,
-	0xb2, 0x5c, 0x99, 0x16, 0xb4, 0x39, 0x6d, 0xbb, 0x9a, 0xff, 0x9e, 0xe5, 0x6a, 0x62, 0x58, 0xf2,
-	0x15, 0x74, 0xd4, 0xb2, 0xec, 0x52, 0x2d, 0x73, 0x61, 0x0f, 0xf5, 0xf5, 0xaa, 0x5a, 0x13, 0x3e,
-	0x5b, 0x3a, 0xc2, 0x57, 0x6e, 0x14, 0xbc, 0x6a, 0x80, 0x5f, 0xd2, 0xd5, 0x06, 0xea, 0xbd, 0xb5,
-	0x81, 0x9e, 0x40, 0x27, 0x5f, 0xf3, 0xd8, 0x4e, 0xb5, 0x7d, 0xe7, 0xde, 0x5b, 0xfe, 0x31, 0x9c,
-	0xac, 0x79, 0x6c, 0x25, 0x72, 0x37, 0x22, 0x63, 0xd8, 0x5d, 0xb1, 0x34, 0x99, 0x31, 0x25, 0x32,
-	0xab, 0xb3, 0xd5, 0x5f, 0xaf, 0xd3, 0x79, 0x5e, 0x66, 0x18, 0xb1, 0xde, 0x6a, 0x1b, 0x06, 0x7f,
-	0xd7, 0xc0, 0x2f, 0xff, 0xe8, 0xcd, 0xbb, 0xdd, 0x7c, 0xe7, 0xdd, 0xae, 0xdd, 0x60, 0xb7, 0xeb,
-	0x37, 0xda, 0x6d, 0xef, 0xcd, 0xbb, 0x7d, 0x17, 0xba, 0x31, 0x53, 0xf1, 0x22, 0xe1, 0xf3, 0xa8,
-	0x90, 0xee, 0x6b, 0x0a, 0x25, 0xf5, 0xa3, 0x0c, 0xfe, 0xac, 0x41, 0xaf, 0xb2, 0x7c, 0x7d, 0xd4,
-	0xcb, 0xef, 0xb5, 0x7b, 0xb8, 0x38, 0x48, 0x46, 0xd0, 0x96, 0xc5, 0x34, 0xba, 0xc0, 0xb5, 0xdb,
-	0x9c, 0x4f, 0xde, 0xd9, 0xd4, 0x70, 0x5c, 0x4c, 0x1f, 0xe1, 0x9a, 0xb6, 0xa4, 0xf9, 0x25, 0x1f,
-	0xc0, 0xce, 0x4a, 0x28, 0x5d, 0x95, 0x14, 0x2f, 0x30, 0x73, 0x8b, 0xed, 0x5a, 0x6e, 0xac, 0xa9,
-	0x60, 0x08, 0x2d, 0x9b, 0xa4, 0x3b, 0xa8, 0x5a, 0x4b, 0x74, 0x77, 0xc5, 0x8c, 0x75, 0x07, 0x5d,
-	0xb1, 0xb4, 0xc0, 0xb2, 0x0f, 0x1b, 0x70, 0x4c, 0x81, 0x8c, 0xb3, 0x82, 0xa3, 0x31, 0x20, 0xa7,
-	0xf8, 0x53, 0x81, 0xb9, 0xd2, 0x26, 0x9c, 0x67, 0x62, 0x59, 0x1a, 0x6b, 0xbb, 0x0b, 0x68, 0xca,
-	0x39, 0x7a, 0x08, 0x1d, 0x25, 0xaa, 0xbe, 0xfb, 0x4a, 0xd8, 0xe0, 0xf1, 0x23, 0xd8, 0x3b, 0x43,
-	0x96, 0x9a, 0xc7, 0x81, 0x13, 0xdc, 0xb2, 0xa8, 0x56, 0xb5, 0xe8, 0x7d, 0x00, 0xa9, 0x1b, 0x83,
-	0x7e, 0x8f, 0x58, 0x29, 0x9f, 0x6e, 0x31, 0xc3, 0x57, 0x35, 0xd8, 0x7d, 0x6c, 0xdf, 0xb3, 0x13,
-	0xcc, 0x56, 0x49, 0x8c, 0xe4, 0x73, 0x68, 0xb9, 0x9b, 0x72, 0x3b, 0xb4, 0xef, 0xda, 0xb0, 0x7c,
-	0xd7, 0x86, 0xdf, 0xe8, 0x77, 0x6d, 0x40, 0xae, 0xda, 0x4c, 0xbe, 0x80, 0xb6, 0xfb, 0xcc, 0x5d,
-	0x9b, 0x76, 0xeb, 0x0d, 0xdf, 0x42, 0xf2, 0x35, 0x74, 0xb7, 0x1c, 0x22, 0xb7, 0xf5, 0x9c, 0xab,
-	0x96, 0x05, 0xd7, 0x68, 0x92, 0x2f, 0xc1, 0x2f, 0xcd, 0x20, 0x46, 0xff, 0x92, 0x35, 0xd7, 0x25,
-	0x4e, 0x5b, 0x06, 0x7f, 0xf6, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb4, 0x91, 0x0e, 0xb5, 0xce,
-	0x0b, 0x00, 0x00,
+func (m *StopPeerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StopPeerRequest.Unmarshal(m, b)
+}
+func (m *StopPeerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StopPeerRequest.Marshal(b, m, deterministic)
+}
+func (m *StopPeerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopPeerRequest.Merge(m, src)
+}
+func (m *StopPeerRequest) XXX_Size() int {
+	return xxx_messageInfo_StopPeerRequest.Size(m)
+}
+func (m *StopPeerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopPeerRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_StopPeerRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *StopPeerRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
 
-// ManagerServiceClient is the client API for ManagerService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ManagerServiceClient interface {
-	Status(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*StatusResponse, error)
-	NetInfo(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*NetInfoResponse, error)
-	PruneBlocks(ctx context.Context, in *PruneBlocksRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	DealPeer(ctx context.Context, in *DealPeerRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+type BanPeerRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Duration             int64    `protobuf:"varint,2,opt,name=duration,proto3" json:"duration"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type managerServiceClient struct {
-	cc *grpc.ClientConn
+func (m *BanPeerRequest) Reset()         { *m = BanPeerRequest{} }
+func (m *BanPeerRequest) String() string { return proto.CompactTextString(m) }
+func (*BanPeerRequest) ProtoMessage()    {}
+func (*BanPeerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{8}
 }
 
-func NewManagerServiceClient(cc *grpc.ClientConn) ManagerServiceClient {
-	return &managerServiceClient{cc}
+func (m *BanPeerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BanPeerRequest.Unmarshal(m, b)
+}
+func (m *BanPeerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BanPeerRequest.Marshal(b, m, deterministic)
+}
+func (m *BanPeerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BanPeerRequest.Merge(m, src)
+}
+func (m *BanPeerRequest) XXX_Size() int {
+	return xxx_messageInfo_BanPeerRequest.Size(m)
+}
+func (m *BanPeerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BanPeerRequest.DiscardUnknown(m)
 }
 
-func (c *managerServiceClient) Status(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*StatusResponse, error) {
-	out := new(StatusResponse)
-	err := c.cc.Invoke(ctx, "/pb.ManagerService/Status", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BanPeerRequest proto.InternalMessageInfo
+
+func (m *BanPeerRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return ""
 }
 
-func (c *managerServiceClient) NetInfo(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*NetInfoResponse, error) {
-	out := new(NetInfoResponse)
-	err := c.cc.Invoke(ctx, "/pb.ManagerService/NetInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BanPeerRequest) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
 	}
-	return out, nil
+	return 0
 }
 
-func (c *managerServiceClient) PruneBlocks(ctx context.Context, in *PruneBlocksRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/pb.ManagerService/PruneBlocks", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type UnbanPeerRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *managerServiceClient) DealPeer(ctx context.Context, in *DealPeerRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/pb.ManagerService/DealPeer", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *UnbanPeerRequest) Reset()         { *m = UnbanPeerRequest{} }
+func (m *UnbanPeerRequest) String() string { return proto.CompactTextString(m) }
+func (*UnbanPeerRequest) ProtoMessage()    {}
+func (*UnbanPeerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{9}
+}
+
+func (m *UnbanPeerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnbanPeerRequest.Unmarshal(m, b)
+}
+func (m *UnbanPeerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnbanPeerRequest.Marshal(b, m, deterministic)
+}
+func (m *UnbanPeerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbanPeerRequest.Merge(m, src)
+}
+func (m *UnbanPeerRequest) XXX_Size() int {
+	return xxx_messageInfo_UnbanPeerRequest.Size(m)
+}
+func (m *UnbanPeerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbanPeerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbanPeerRequest proto.InternalMessageInfo
+
+func (m *UnbanPeerRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return ""
 }
 
-// ManagerServiceServer is the server API for ManagerService service.
-type ManagerServiceServer interface {
-	Status(context.Context, *empty.Empty) (*StatusResponse, error)
-	NetInfo(context.Context, *empty.Empty) (*NetInfoResponse, error)
-	PruneBlocks(context.Context, *PruneBlocksRequest) (*empty.Empty, error)
-	DealPeer(context.Context, *DealPeerRequest) (*empty.Empty, error)
+type BannedPeersResponse struct {
+	Peers                []*BannedPeersResponse_Peer `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
 }
 
-// UnimplementedManagerServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedManagerServiceServer struct {
+func (m *BannedPeersResponse) Reset()         { *m = BannedPeersResponse{} }
+func (m *BannedPeersResponse) String() string { return proto.CompactTextString(m) }
+func (*BannedPeersResponse) ProtoMessage()    {}
+func (*BannedPeersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{10}
 }
 
-func (*UnimplementedManagerServiceServer) Status(ctx context.Context, req *empty.Empty) (*StatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
+func (m *BannedPeersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BannedPeersResponse.Unmarshal(m, b)
 }
-func (*UnimplementedManagerServiceServer) NetInfo(ctx context.Context, req *empty.Empty) (*NetInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NetInfo not implemented")
+func (m *BannedPeersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BannedPeersResponse.Marshal(b, m, deterministic)
 }
-func (*UnimplementedManagerServiceServer) PruneBlocks(ctx context.Context, req *PruneBlocksRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PruneBlocks not implemented")
+func (m *BannedPeersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BannedPeersResponse.Merge(m, src)
 }
-func (*UnimplementedManagerServiceServer) DealPeer(ctx context.Context, req *DealPeerRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DealPeer not implemented")
+func (m *BannedPeersResponse) XXX_Size() int {
+	return xxx_messageInfo_BannedPeersResponse.Size(m)
 }
-
-func RegisterManagerServiceServer(s *grpc.Server, srv ManagerServiceServer) {
-	s.RegisterService(&_ManagerService_serviceDesc, srv)
+func (m *BannedPeersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BannedPeersResponse.DiscardUnknown(m)
 }
 
-func _ManagerService_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ManagerServiceServer).Status(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.ManagerService/Status",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ManagerServiceServer).Status(ctx, req.(*empty.Empty))
+var xxx_messageInfo_BannedPeersResponse proto.InternalMessageInfo
+
+func (m *BannedPeersResponse) GetPeers() []*BannedPeersResponse_Peer {
+	if m != nil {
+		return m.Peers
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _ManagerService_NetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ManagerServiceServer).NetInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.ManagerService/NetInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ManagerServiceServer).NetInfo(ctx, req.(*empty.Empty))
-	}
-	return interceptor(ctx, in, info, handler)
+type BannedPeersResponse_Peer struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Until                string   `protobuf:"bytes,2,opt,name=until,proto3" json:"until"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _ManagerService_PruneBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PruneBlocksRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ManagerServiceServer).PruneBlocks(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.ManagerService/PruneBlocks",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ManagerServiceServer).PruneBlocks(ctx, req.(*PruneBlocksRequest))
+func (m *BannedPeersResponse_Peer) Reset()         { *m = BannedPeersResponse_Peer{} }
+func (m *BannedPeersResponse_Peer) String() string { return proto.CompactTextString(m) }
+func (*BannedPeersResponse_Peer) ProtoMessage()    {}
+func (*BannedPeersResponse_Peer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{10, 0}
+}
+
+func (m *BannedPeersResponse_Peer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BannedPeersResponse_Peer.Unmarshal(m, b)
+}
+func (m *BannedPeersResponse_Peer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BannedPeersResponse_Peer.Marshal(b, m, deterministic)
+}
+func (m *BannedPeersResponse_Peer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BannedPeersResponse_Peer.Merge(m, src)
+}
+func (m *BannedPeersResponse_Peer) XXX_Size() int {
+	return xxx_messageInfo_BannedPeersResponse_Peer.Size(m)
+}
+func (m *BannedPeersResponse_Peer) XXX_DiscardUnknown() {
+	xxx_messageInfo_BannedPeersResponse_Peer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BannedPeersResponse_Peer proto.InternalMessageInfo
+
+func (m *BannedPeersResponse_Peer) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _ManagerService_DealPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DealPeerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *BannedPeersResponse_Peer) GetUntil() string {
+	if m != nil {
+		return m.Until
 	}
-	if interceptor == nil {
-		return srv.(ManagerServiceServer).DealPeer(ctx, in)
+	return ""
+}
+
+type AuditLogRequest struct {
+	Since                string   `protobuf:"bytes,1,opt,name=since,proto3" json:"since"`
+	Method               string   `protobuf:"bytes,2,opt,name=method,proto3" json:"method"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditLogRequest) Reset()         { *m = AuditLogRequest{} }
+func (m *AuditLogRequest) String() string { return proto.CompactTextString(m) }
+func (*AuditLogRequest) ProtoMessage()    {}
+func (*AuditLogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{11}
+}
+
+func (m *AuditLogRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditLogRequest.Unmarshal(m, b)
+}
+func (m *AuditLogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditLogRequest.Marshal(b, m, deterministic)
+}
+func (m *AuditLogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditLogRequest.Merge(m, src)
+}
+func (m *AuditLogRequest) XXX_Size() int {
+	return xxx_messageInfo_AuditLogRequest.Size(m)
+}
+func (m *AuditLogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditLogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditLogRequest proto.InternalMessageInfo
+
+func (m *AuditLogRequest) GetSince() string {
+	if m != nil {
+		return m.Since
+	}
+	return ""
+}
+
+func (m *AuditLogRequest) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+type AuditLogResponse struct {
+	Entries              []*AuditLogResponse_Entry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *AuditLogResponse) Reset()         { *m = AuditLogResponse{} }
+func (m *AuditLogResponse) String() string { return proto.CompactTextString(m) }
+func (*AuditLogResponse) ProtoMessage()    {}
+func (*AuditLogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{12}
+}
+
+func (m *AuditLogResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditLogResponse.Unmarshal(m, b)
+}
+func (m *AuditLogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditLogResponse.Marshal(b, m, deterministic)
+}
+func (m *AuditLogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditLogResponse.Merge(m, src)
+}
+func (m *AuditLogResponse) XXX_Size() int {
+	return xxx_messageInfo_AuditLogResponse.Size(m)
+}
+func (m *AuditLogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditLogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditLogResponse proto.InternalMessageInfo
+
+func (m *AuditLogResponse) GetEntries() []*AuditLogResponse_Entry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type AuditLogResponse_Entry struct {
+	Time                 string   `protobuf:"bytes,1,opt,name=time,proto3" json:"time"`
+	Caller               string   `protobuf:"bytes,2,opt,name=caller,proto3" json:"caller"`
+	Method               string   `protobuf:"bytes,3,opt,name=method,proto3" json:"method"`
+	Request              string   `protobuf:"bytes,4,opt,name=request,proto3" json:"request"`
+	Code                 string   `protobuf:"bytes,5,opt,name=code,proto3" json:"code"`
+	Latency              int64    `protobuf:"varint,6,opt,name=latency,proto3" json:"latency"`
+	PrevHash             string   `protobuf:"bytes,7,opt,name=prev_hash,json=prevHash,proto3" json:"prev_hash"`
+	Hash                 string   `protobuf:"bytes,8,opt,name=hash,proto3" json:"hash"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditLogResponse_Entry) Reset()         { *m = AuditLogResponse_Entry{} }
+func (m *AuditLogResponse_Entry) String() string { return proto.CompactTextString(m) }
+func (*AuditLogResponse_Entry) ProtoMessage()    {}
+func (*AuditLogResponse_Entry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{12, 0}
+}
+
+func (m *AuditLogResponse_Entry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditLogResponse_Entry.Unmarshal(m, b)
+}
+func (m *AuditLogResponse_Entry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditLogResponse_Entry.Marshal(b, m, deterministic)
+}
+func (m *AuditLogResponse_Entry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditLogResponse_Entry.Merge(m, src)
+}
+func (m *AuditLogResponse_Entry) XXX_Size() int {
+	return xxx_messageInfo_AuditLogResponse_Entry.Size(m)
+}
+func (m *AuditLogResponse_Entry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditLogResponse_Entry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditLogResponse_Entry proto.InternalMessageInfo
+
+func (m *AuditLogResponse_Entry) GetTime() string {
+	if m != nil {
+		return m.Time
+	}
+	return ""
+}
+
+func (m *AuditLogResponse_Entry) GetCaller() string {
+	if m != nil {
+		return m.Caller
+	}
+	return ""
+}
+
+func (m *AuditLogResponse_Entry) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *AuditLogResponse_Entry) GetRequest() string {
+	if m != nil {
+		return m.Request
+	}
+	return ""
+}
+
+func (m *AuditLogResponse_Entry) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *AuditLogResponse_Entry) GetLatency() int64 {
+	if m != nil {
+		return m.Latency
+	}
+	return 0
+}
+
+func (m *AuditLogResponse_Entry) GetPrevHash() string {
+	if m != nil {
+		return m.PrevHash
+	}
+	return ""
+}
+
+func (m *AuditLogResponse_Entry) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+type Transaction struct {
+	Hash                 string   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash"`
+	RawTx                string   `protobuf:"bytes,2,opt,name=raw_tx,json=rawTx,proto3" json:"raw_tx"`
+	From                 string   `protobuf:"bytes,3,opt,name=from,proto3" json:"from"`
+	Nonce                uint64   `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce"`
+	GasPrice             uint32   `protobuf:"varint,5,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price"`
+	Type                 string   `protobuf:"bytes,6,opt,name=type,proto3" json:"type"`
+	GasCoin              string   `protobuf:"bytes,7,opt,name=gas_coin,json=gasCoin,proto3" json:"gas_coin"`
+	Gas                  int64    `protobuf:"varint,8,opt,name=gas,proto3" json:"gas"`
+	Payload              []byte   `protobuf:"bytes,9,opt,name=payload,proto3" json:"payload"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Transaction) Reset()         { *m = Transaction{} }
+func (m *Transaction) String() string { return proto.CompactTextString(m) }
+func (*Transaction) ProtoMessage()    {}
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{13}
+}
+
+func (m *Transaction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Transaction.Unmarshal(m, b)
+}
+func (m *Transaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Transaction.Marshal(b, m, deterministic)
+}
+func (m *Transaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Transaction.Merge(m, src)
+}
+func (m *Transaction) XXX_Size() int {
+	return xxx_messageInfo_Transaction.Size(m)
+}
+func (m *Transaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_Transaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Transaction proto.InternalMessageInfo
+
+func (m *Transaction) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *Transaction) GetRawTx() string {
+	if m != nil {
+		return m.RawTx
+	}
+	return ""
+}
+
+func (m *Transaction) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *Transaction) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *Transaction) GetGasPrice() uint32 {
+	if m != nil {
+		return m.GasPrice
+	}
+	return 0
+}
+
+func (m *Transaction) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Transaction) GetGasCoin() string {
+	if m != nil {
+		return m.GasCoin
+	}
+	return ""
+}
+
+func (m *Transaction) GetGas() int64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func (m *Transaction) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+type UnconfirmedTxsRequest struct {
+	Limit                int64    `protobuf:"varint,1,opt,name=limit,proto3" json:"limit"`
+	Sender               string   `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnconfirmedTxsRequest) Reset()         { *m = UnconfirmedTxsRequest{} }
+func (m *UnconfirmedTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*UnconfirmedTxsRequest) ProtoMessage()    {}
+func (*UnconfirmedTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{14}
+}
+
+func (m *UnconfirmedTxsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnconfirmedTxsRequest.Unmarshal(m, b)
+}
+func (m *UnconfirmedTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnconfirmedTxsRequest.Marshal(b, m, deterministic)
+}
+func (m *UnconfirmedTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnconfirmedTxsRequest.Merge(m, src)
+}
+func (m *UnconfirmedTxsRequest) XXX_Size() int {
+	return xxx_messageInfo_UnconfirmedTxsRequest.Size(m)
+}
+func (m *UnconfirmedTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnconfirmedTxsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnconfirmedTxsRequest proto.InternalMessageInfo
+
+func (m *UnconfirmedTxsRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *UnconfirmedTxsRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+type UnconfirmedTxsResponse struct {
+	NTxs                 int64          `protobuf:"varint,1,opt,name=n_txs,json=nTxs,proto3" json:"n_txs"`
+	Total                int64          `protobuf:"varint,2,opt,name=total,proto3" json:"total"`
+	TotalBytes           int64          `protobuf:"varint,3,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes"`
+	Txs                  []*Transaction `protobuf:"bytes,4,rep,name=txs,proto3" json:"txs"`
+	Truncated            bool           `protobuf:"varint,5,opt,name=truncated,proto3" json:"truncated"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *UnconfirmedTxsResponse) Reset()         { *m = UnconfirmedTxsResponse{} }
+func (m *UnconfirmedTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*UnconfirmedTxsResponse) ProtoMessage()    {}
+func (*UnconfirmedTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{15}
+}
+
+func (m *UnconfirmedTxsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnconfirmedTxsResponse.Unmarshal(m, b)
+}
+func (m *UnconfirmedTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnconfirmedTxsResponse.Marshal(b, m, deterministic)
+}
+func (m *UnconfirmedTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnconfirmedTxsResponse.Merge(m, src)
+}
+func (m *UnconfirmedTxsResponse) XXX_Size() int {
+	return xxx_messageInfo_UnconfirmedTxsResponse.Size(m)
+}
+func (m *UnconfirmedTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnconfirmedTxsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnconfirmedTxsResponse proto.InternalMessageInfo
+
+func (m *UnconfirmedTxsResponse) GetNTxs() int64 {
+	if m != nil {
+		return m.NTxs
+	}
+	return 0
+}
+
+func (m *UnconfirmedTxsResponse) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *UnconfirmedTxsResponse) GetTotalBytes() int64 {
+	if m != nil {
+		return m.TotalBytes
+	}
+	return 0
+}
+
+func (m *UnconfirmedTxsResponse) GetTxs() []*Transaction {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func (m *UnconfirmedTxsResponse) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
+	}
+	return false
+}
+
+type NumUnconfirmedTxsResponse struct {
+	NTxs                 int64    `protobuf:"varint,1,opt,name=n_txs,json=nTxs,proto3" json:"n_txs"`
+	Total                int64    `protobuf:"varint,2,opt,name=total,proto3" json:"total"`
+	TotalBytes           int64    `protobuf:"varint,3,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NumUnconfirmedTxsResponse) Reset()         { *m = NumUnconfirmedTxsResponse{} }
+func (m *NumUnconfirmedTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*NumUnconfirmedTxsResponse) ProtoMessage()    {}
+func (*NumUnconfirmedTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{16}
+}
+
+func (m *NumUnconfirmedTxsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NumUnconfirmedTxsResponse.Unmarshal(m, b)
+}
+func (m *NumUnconfirmedTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NumUnconfirmedTxsResponse.Marshal(b, m, deterministic)
+}
+func (m *NumUnconfirmedTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NumUnconfirmedTxsResponse.Merge(m, src)
+}
+func (m *NumUnconfirmedTxsResponse) XXX_Size() int {
+	return xxx_messageInfo_NumUnconfirmedTxsResponse.Size(m)
+}
+func (m *NumUnconfirmedTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NumUnconfirmedTxsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NumUnconfirmedTxsResponse proto.InternalMessageInfo
+
+func (m *NumUnconfirmedTxsResponse) GetNTxs() int64 {
+	if m != nil {
+		return m.NTxs
+	}
+	return 0
+}
+
+func (m *NumUnconfirmedTxsResponse) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *NumUnconfirmedTxsResponse) GetTotalBytes() int64 {
+	if m != nil {
+		return m.TotalBytes
+	}
+	return 0
+}
+
+type BlockRequest struct {
+	Height               int64    `protobuf:"varint,1,opt,name=height,proto3" json:"height"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockRequest) Reset()         { *m = BlockRequest{} }
+func (m *BlockRequest) String() string { return proto.CompactTextString(m) }
+func (*BlockRequest) ProtoMessage()    {}
+func (*BlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{17}
+}
+
+func (m *BlockRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockRequest.Unmarshal(m, b)
+}
+func (m *BlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockRequest.Marshal(b, m, deterministic)
+}
+func (m *BlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockRequest.Merge(m, src)
+}
+func (m *BlockRequest) XXX_Size() int {
+	return xxx_messageInfo_BlockRequest.Size(m)
+}
+func (m *BlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockRequest proto.InternalMessageInfo
+
+func (m *BlockRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type BlockResponse struct {
+	Hash                 string                     `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash"`
+	Height               int64                      `protobuf:"varint,2,opt,name=height,proto3" json:"height"`
+	Time                 string                     `protobuf:"bytes,3,opt,name=time,proto3" json:"time"`
+	NumTxs               int64                      `protobuf:"varint,4,opt,name=num_txs,json=numTxs,proto3" json:"num_txs"`
+	Proposer             string                     `protobuf:"bytes,5,opt,name=proposer,proto3" json:"proposer"`
+	Transactions         []*Transaction             `protobuf:"bytes,6,rep,name=transactions,proto3" json:"transactions"`
+	Signatures           []*BlockResponse_Signature `protobuf:"bytes,7,rep,name=signatures,proto3" json:"signatures"`
+	MissingSignatures    int64                      `protobuf:"varint,8,opt,name=missing_signatures,json=missingSignatures,proto3" json:"missing_signatures"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *BlockResponse) Reset()         { *m = BlockResponse{} }
+func (m *BlockResponse) String() string { return proto.CompactTextString(m) }
+func (*BlockResponse) ProtoMessage()    {}
+func (*BlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{18}
+}
+
+func (m *BlockResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockResponse.Unmarshal(m, b)
+}
+func (m *BlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockResponse.Marshal(b, m, deterministic)
+}
+func (m *BlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockResponse.Merge(m, src)
+}
+func (m *BlockResponse) XXX_Size() int {
+	return xxx_messageInfo_BlockResponse.Size(m)
+}
+func (m *BlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockResponse proto.InternalMessageInfo
+
+func (m *BlockResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *BlockResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockResponse) GetTime() string {
+	if m != nil {
+		return m.Time
+	}
+	return ""
+}
+
+func (m *BlockResponse) GetNumTxs() int64 {
+	if m != nil {
+		return m.NumTxs
+	}
+	return 0
+}
+
+func (m *BlockResponse) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *BlockResponse) GetTransactions() []*Transaction {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+func (m *BlockResponse) GetSignatures() []*BlockResponse_Signature {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+func (m *BlockResponse) GetMissingSignatures() int64 {
+	if m != nil {
+		return m.MissingSignatures
+	}
+	return 0
+}
+
+type BlockResponse_Signature struct {
+	ValidatorAddress     string   `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address"`
+	VotingPower          int64    `protobuf:"varint,2,opt,name=voting_power,json=votingPower,proto3" json:"voting_power"`
+	Signed               bool     `protobuf:"varint,3,opt,name=signed,proto3" json:"signed"`
+	Signature            string   `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockResponse_Signature) Reset()         { *m = BlockResponse_Signature{} }
+func (m *BlockResponse_Signature) String() string { return proto.CompactTextString(m) }
+func (*BlockResponse_Signature) ProtoMessage()    {}
+func (*BlockResponse_Signature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{18, 0}
+}
+
+func (m *BlockResponse_Signature) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockResponse_Signature.Unmarshal(m, b)
+}
+func (m *BlockResponse_Signature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockResponse_Signature.Marshal(b, m, deterministic)
+}
+func (m *BlockResponse_Signature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockResponse_Signature.Merge(m, src)
+}
+func (m *BlockResponse_Signature) XXX_Size() int {
+	return xxx_messageInfo_BlockResponse_Signature.Size(m)
+}
+func (m *BlockResponse_Signature) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockResponse_Signature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockResponse_Signature proto.InternalMessageInfo
+
+func (m *BlockResponse_Signature) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *BlockResponse_Signature) GetVotingPower() int64 {
+	if m != nil {
+		return m.VotingPower
+	}
+	return 0
+}
+
+func (m *BlockResponse_Signature) GetSigned() bool {
+	if m != nil {
+		return m.Signed
+	}
+	return false
+}
+
+func (m *BlockResponse_Signature) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+type Event struct {
+	Type                 string             `protobuf:"bytes,1,opt,name=type,proto3" json:"type"`
+	Attributes           []*Event_Attribute `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *Event) Reset()         { *m = Event{} }
+func (m *Event) String() string { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()    {}
+func (*Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{19}
+}
+
+func (m *Event) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Event.Unmarshal(m, b)
+}
+func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+}
+func (m *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(m, src)
+}
+func (m *Event) XXX_Size() int {
+	return xxx_messageInfo_Event.Size(m)
+}
+func (m *Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Event proto.InternalMessageInfo
+
+func (m *Event) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Event) GetAttributes() []*Event_Attribute {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+type Event_Attribute struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key"`
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Event_Attribute) Reset()         { *m = Event_Attribute{} }
+func (m *Event_Attribute) String() string { return proto.CompactTextString(m) }
+func (*Event_Attribute) ProtoMessage()    {}
+func (*Event_Attribute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{19, 0}
+}
+
+func (m *Event_Attribute) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Event_Attribute.Unmarshal(m, b)
+}
+func (m *Event_Attribute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Event_Attribute.Marshal(b, m, deterministic)
+}
+func (m *Event_Attribute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event_Attribute.Merge(m, src)
+}
+func (m *Event_Attribute) XXX_Size() int {
+	return xxx_messageInfo_Event_Attribute.Size(m)
+}
+func (m *Event_Attribute) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event_Attribute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Event_Attribute proto.InternalMessageInfo
+
+func (m *Event_Attribute) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *Event_Attribute) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type TxResult struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code"`
+	Log                  string   `protobuf:"bytes,2,opt,name=log,proto3" json:"log"`
+	Info                 string   `protobuf:"bytes,3,opt,name=info,proto3" json:"info"`
+	GasWanted            int64    `protobuf:"varint,4,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted"`
+	GasUsed              int64    `protobuf:"varint,5,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used"`
+	Events               []*Event `protobuf:"bytes,6,rep,name=events,proto3" json:"events"`
+	Codespace            string   `protobuf:"bytes,7,opt,name=codespace,proto3" json:"codespace"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TxResult) Reset()         { *m = TxResult{} }
+func (m *TxResult) String() string { return proto.CompactTextString(m) }
+func (*TxResult) ProtoMessage()    {}
+func (*TxResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{20}
+}
+
+func (m *TxResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxResult.Unmarshal(m, b)
+}
+func (m *TxResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxResult.Marshal(b, m, deterministic)
+}
+func (m *TxResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxResult.Merge(m, src)
+}
+func (m *TxResult) XXX_Size() int {
+	return xxx_messageInfo_TxResult.Size(m)
+}
+func (m *TxResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxResult proto.InternalMessageInfo
+
+func (m *TxResult) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *TxResult) GetLog() string {
+	if m != nil {
+		return m.Log
+	}
+	return ""
+}
+
+func (m *TxResult) GetInfo() string {
+	if m != nil {
+		return m.Info
+	}
+	return ""
+}
+
+func (m *TxResult) GetGasWanted() int64 {
+	if m != nil {
+		return m.GasWanted
+	}
+	return 0
+}
+
+func (m *TxResult) GetGasUsed() int64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *TxResult) GetEvents() []*Event {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *TxResult) GetCodespace() string {
+	if m != nil {
+		return m.Codespace
+	}
+	return ""
+}
+
+type BlockResultsResponse struct {
+	Height               int64                               `protobuf:"varint,1,opt,name=height,proto3" json:"height"`
+	DeliverTx            []*TxResult                         `protobuf:"bytes,2,rep,name=deliver_tx,json=deliverTx,proto3" json:"deliver_tx"`
+	BeginBlockEvents     []*Event                            `protobuf:"bytes,3,rep,name=begin_block_events,json=beginBlockEvents,proto3" json:"begin_block_events"`
+	EndBlockEvents       []*Event                            `protobuf:"bytes,4,rep,name=end_block_events,json=endBlockEvents,proto3" json:"end_block_events"`
+	MinterEvents         []*BlockResultsResponse_MinterEvent `protobuf:"bytes,5,rep,name=minter_events,json=minterEvents,proto3" json:"minter_events"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
+}
+
+func (m *BlockResultsResponse) Reset()         { *m = BlockResultsResponse{} }
+func (m *BlockResultsResponse) String() string { return proto.CompactTextString(m) }
+func (*BlockResultsResponse) ProtoMessage()    {}
+func (*BlockResultsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{21}
+}
+
+func (m *BlockResultsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockResultsResponse.Unmarshal(m, b)
+}
+func (m *BlockResultsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockResultsResponse.Marshal(b, m, deterministic)
+}
+func (m *BlockResultsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockResultsResponse.Merge(m, src)
+}
+func (m *BlockResultsResponse) XXX_Size() int {
+	return xxx_messageInfo_BlockResultsResponse.Size(m)
+}
+func (m *BlockResultsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockResultsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockResultsResponse proto.InternalMessageInfo
+
+func (m *BlockResultsResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockResultsResponse) GetDeliverTx() []*TxResult {
+	if m != nil {
+		return m.DeliverTx
+	}
+	return nil
+}
+
+func (m *BlockResultsResponse) GetBeginBlockEvents() []*Event {
+	if m != nil {
+		return m.BeginBlockEvents
+	}
+	return nil
+}
+
+func (m *BlockResultsResponse) GetEndBlockEvents() []*Event {
+	if m != nil {
+		return m.EndBlockEvents
+	}
+	return nil
+}
+
+func (m *BlockResultsResponse) GetMinterEvents() []*BlockResultsResponse_MinterEvent {
+	if m != nil {
+		return m.MinterEvents
+	}
+	return nil
+}
+
+type BlockResultsResponse_MinterEvent struct {
+	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type"`
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockResultsResponse_MinterEvent) Reset()         { *m = BlockResultsResponse_MinterEvent{} }
+func (m *BlockResultsResponse_MinterEvent) String() string { return proto.CompactTextString(m) }
+func (*BlockResultsResponse_MinterEvent) ProtoMessage()    {}
+func (*BlockResultsResponse_MinterEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{21, 0}
+}
+
+func (m *BlockResultsResponse_MinterEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockResultsResponse_MinterEvent.Unmarshal(m, b)
+}
+func (m *BlockResultsResponse_MinterEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockResultsResponse_MinterEvent.Marshal(b, m, deterministic)
+}
+func (m *BlockResultsResponse_MinterEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockResultsResponse_MinterEvent.Merge(m, src)
+}
+func (m *BlockResultsResponse_MinterEvent) XXX_Size() int {
+	return xxx_messageInfo_BlockResultsResponse_MinterEvent.Size(m)
+}
+func (m *BlockResultsResponse_MinterEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockResultsResponse_MinterEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockResultsResponse_MinterEvent proto.InternalMessageInfo
+
+func (m *BlockResultsResponse_MinterEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *BlockResultsResponse_MinterEvent) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type TxRequest struct {
+	Hash                 string   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TxRequest) Reset()         { *m = TxRequest{} }
+func (m *TxRequest) String() string { return proto.CompactTextString(m) }
+func (*TxRequest) ProtoMessage()    {}
+func (*TxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{22}
+}
+
+func (m *TxRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxRequest.Unmarshal(m, b)
+}
+func (m *TxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxRequest.Marshal(b, m, deterministic)
+}
+func (m *TxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxRequest.Merge(m, src)
+}
+func (m *TxRequest) XXX_Size() int {
+	return xxx_messageInfo_TxRequest.Size(m)
+}
+func (m *TxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxRequest proto.InternalMessageInfo
+
+func (m *TxRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+type TxResponse struct {
+	Height               int64        `protobuf:"varint,1,opt,name=height,proto3" json:"height"`
+	Index                uint32       `protobuf:"varint,2,opt,name=index,proto3" json:"index"`
+	Transaction          *Transaction `protobuf:"bytes,3,opt,name=transaction,proto3" json:"transaction"`
+	Result               *TxResult    `protobuf:"bytes,4,opt,name=result,proto3" json:"result"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *TxResponse) Reset()         { *m = TxResponse{} }
+func (m *TxResponse) String() string { return proto.CompactTextString(m) }
+func (*TxResponse) ProtoMessage()    {}
+func (*TxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{23}
+}
+
+func (m *TxResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxResponse.Unmarshal(m, b)
+}
+func (m *TxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxResponse.Marshal(b, m, deterministic)
+}
+func (m *TxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxResponse.Merge(m, src)
+}
+func (m *TxResponse) XXX_Size() int {
+	return xxx_messageInfo_TxResponse.Size(m)
+}
+func (m *TxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxResponse proto.InternalMessageInfo
+
+func (m *TxResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *TxResponse) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *TxResponse) GetTransaction() *Transaction {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+func (m *TxResponse) GetResult() *TxResult {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type ValidatorRequest struct {
+	Blocks               int64    `protobuf:"varint,1,opt,name=blocks,proto3" json:"blocks"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorRequest) Reset()         { *m = ValidatorRequest{} }
+func (m *ValidatorRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRequest) ProtoMessage()    {}
+func (*ValidatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{24}
+}
+
+func (m *ValidatorRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorRequest.Unmarshal(m, b)
+}
+func (m *ValidatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorRequest.Marshal(b, m, deterministic)
+}
+func (m *ValidatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRequest.Merge(m, src)
+}
+func (m *ValidatorRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidatorRequest.Size(m)
+}
+func (m *ValidatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRequest proto.InternalMessageInfo
+
+func (m *ValidatorRequest) GetBlocks() int64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+type ValidatorResponse struct {
+	PubKey               string   `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key"`
+	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address"`
+	Candidate            bool     `protobuf:"varint,3,opt,name=candidate,proto3" json:"candidate"`
+	CandidateStatus      string   `protobuf:"bytes,4,opt,name=candidate_status,json=candidateStatus,proto3" json:"candidate_status"`
+	Active               bool     `protobuf:"varint,5,opt,name=active,proto3" json:"active"`
+	VotingPower          int64    `protobuf:"varint,6,opt,name=voting_power,json=votingPower,proto3" json:"voting_power"`
+	TotalStake           string   `protobuf:"bytes,7,opt,name=total_stake,json=totalStake,proto3" json:"total_stake"`
+	Commission           uint32   `protobuf:"varint,8,opt,name=commission,proto3" json:"commission"`
+	OwnerAddress         string   `protobuf:"bytes,9,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address"`
+	RewardAddress        string   `protobuf:"bytes,10,opt,name=reward_address,json=rewardAddress,proto3" json:"reward_address"`
+	Blocks               int64    `protobuf:"varint,11,opt,name=blocks,proto3" json:"blocks"`
+	SignedBlocks         int64    `protobuf:"varint,12,opt,name=signed_blocks,json=signedBlocks,proto3" json:"signed_blocks"`
+	MissedBlocks         int64    `protobuf:"varint,13,opt,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks"`
+	MaxMissedBlocks      int64    `protobuf:"varint,14,opt,name=max_missed_blocks,json=maxMissedBlocks,proto3" json:"max_missed_blocks"`
+	MissedBlocksWindow   int64    `protobuf:"varint,15,opt,name=missed_blocks_window,json=missedBlocksWindow,proto3" json:"missed_blocks_window"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorResponse) Reset()         { *m = ValidatorResponse{} }
+func (m *ValidatorResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatorResponse) ProtoMessage()    {}
+func (*ValidatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{25}
+}
+
+func (m *ValidatorResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorResponse.Unmarshal(m, b)
+}
+func (m *ValidatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorResponse.Marshal(b, m, deterministic)
+}
+func (m *ValidatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorResponse.Merge(m, src)
+}
+func (m *ValidatorResponse) XXX_Size() int {
+	return xxx_messageInfo_ValidatorResponse.Size(m)
+}
+func (m *ValidatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorResponse proto.InternalMessageInfo
+
+func (m *ValidatorResponse) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *ValidatorResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ValidatorResponse) GetCandidate() bool {
+	if m != nil {
+		return m.Candidate
+	}
+	return false
+}
+
+func (m *ValidatorResponse) GetCandidateStatus() string {
+	if m != nil {
+		return m.CandidateStatus
+	}
+	return ""
+}
+
+func (m *ValidatorResponse) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func (m *ValidatorResponse) GetVotingPower() int64 {
+	if m != nil {
+		return m.VotingPower
+	}
+	return 0
+}
+
+func (m *ValidatorResponse) GetTotalStake() string {
+	if m != nil {
+		return m.TotalStake
+	}
+	return ""
+}
+
+func (m *ValidatorResponse) GetCommission() uint32 {
+	if m != nil {
+		return m.Commission
+	}
+	return 0
+}
+
+func (m *ValidatorResponse) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
+	}
+	return ""
+}
+
+func (m *ValidatorResponse) GetRewardAddress() string {
+	if m != nil {
+		return m.RewardAddress
+	}
+	return ""
+}
+
+func (m *ValidatorResponse) GetBlocks() int64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+func (m *ValidatorResponse) GetSignedBlocks() int64 {
+	if m != nil {
+		return m.SignedBlocks
+	}
+	return 0
+}
+
+func (m *ValidatorResponse) GetMissedBlocks() int64 {
+	if m != nil {
+		return m.MissedBlocks
+	}
+	return 0
+}
+
+func (m *ValidatorResponse) GetMaxMissedBlocks() int64 {
+	if m != nil {
+		return m.MaxMissedBlocks
+	}
+	return 0
+}
+
+func (m *ValidatorResponse) GetMissedBlocksWindow() int64 {
+	if m != nil {
+		return m.MissedBlocksWindow
+	}
+	return 0
+}
+
+type LogLevelResponse struct {
+	Level                string                     `protobuf:"bytes,1,opt,name=level,proto3" json:"level"`
+	Modules              []*LogLevelResponse_Module `protobuf:"bytes,2,rep,name=modules,proto3" json:"modules"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *LogLevelResponse) Reset()         { *m = LogLevelResponse{} }
+func (m *LogLevelResponse) String() string { return proto.CompactTextString(m) }
+func (*LogLevelResponse) ProtoMessage()    {}
+func (*LogLevelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{26}
+}
+
+func (m *LogLevelResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogLevelResponse.Unmarshal(m, b)
+}
+func (m *LogLevelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogLevelResponse.Marshal(b, m, deterministic)
+}
+func (m *LogLevelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogLevelResponse.Merge(m, src)
+}
+func (m *LogLevelResponse) XXX_Size() int {
+	return xxx_messageInfo_LogLevelResponse.Size(m)
+}
+func (m *LogLevelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogLevelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogLevelResponse proto.InternalMessageInfo
+
+func (m *LogLevelResponse) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
+}
+
+func (m *LogLevelResponse) GetModules() []*LogLevelResponse_Module {
+	if m != nil {
+		return m.Modules
+	}
+	return nil
+}
+
+type LogLevelResponse_Module struct {
+	Module               string   `protobuf:"bytes,1,opt,name=module,proto3" json:"module"`
+	Level                string   `protobuf:"bytes,2,opt,name=level,proto3" json:"level"`
+	Until                string   `protobuf:"bytes,3,opt,name=until,proto3" json:"until"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LogLevelResponse_Module) Reset()         { *m = LogLevelResponse_Module{} }
+func (m *LogLevelResponse_Module) String() string { return proto.CompactTextString(m) }
+func (*LogLevelResponse_Module) ProtoMessage()    {}
+func (*LogLevelResponse_Module) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{26, 0}
+}
+
+func (m *LogLevelResponse_Module) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogLevelResponse_Module.Unmarshal(m, b)
+}
+func (m *LogLevelResponse_Module) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogLevelResponse_Module.Marshal(b, m, deterministic)
+}
+func (m *LogLevelResponse_Module) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogLevelResponse_Module.Merge(m, src)
+}
+func (m *LogLevelResponse_Module) XXX_Size() int {
+	return xxx_messageInfo_LogLevelResponse_Module.Size(m)
+}
+func (m *LogLevelResponse_Module) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogLevelResponse_Module.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogLevelResponse_Module proto.InternalMessageInfo
+
+func (m *LogLevelResponse_Module) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *LogLevelResponse_Module) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
+}
+
+func (m *LogLevelResponse_Module) GetUntil() string {
+	if m != nil {
+		return m.Until
+	}
+	return ""
+}
+
+type SetLogLevelRequest struct {
+	Level                string   `protobuf:"bytes,1,opt,name=level,proto3" json:"level"`
+	Ttl                  int64    `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetLogLevelRequest) Reset()         { *m = SetLogLevelRequest{} }
+func (m *SetLogLevelRequest) String() string { return proto.CompactTextString(m) }
+func (*SetLogLevelRequest) ProtoMessage()    {}
+func (*SetLogLevelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{27}
+}
+
+func (m *SetLogLevelRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetLogLevelRequest.Unmarshal(m, b)
+}
+func (m *SetLogLevelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetLogLevelRequest.Marshal(b, m, deterministic)
+}
+func (m *SetLogLevelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetLogLevelRequest.Merge(m, src)
+}
+func (m *SetLogLevelRequest) XXX_Size() int {
+	return xxx_messageInfo_SetLogLevelRequest.Size(m)
+}
+func (m *SetLogLevelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetLogLevelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetLogLevelRequest proto.InternalMessageInfo
+
+func (m *SetLogLevelRequest) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
+}
+
+func (m *SetLogLevelRequest) GetTtl() int64 {
+	if m != nil {
+		return m.Ttl
+	}
+	return 0
+}
+
+type ProfileRequest struct {
+	Kind                 string   `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind"`
+	Seconds              int64    `protobuf:"varint,2,opt,name=seconds,proto3" json:"seconds"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProfileRequest) Reset()         { *m = ProfileRequest{} }
+func (m *ProfileRequest) String() string { return proto.CompactTextString(m) }
+func (*ProfileRequest) ProtoMessage()    {}
+func (*ProfileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{28}
+}
+
+func (m *ProfileRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProfileRequest.Unmarshal(m, b)
+}
+func (m *ProfileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProfileRequest.Marshal(b, m, deterministic)
+}
+func (m *ProfileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProfileRequest.Merge(m, src)
+}
+func (m *ProfileRequest) XXX_Size() int {
+	return xxx_messageInfo_ProfileRequest.Size(m)
+}
+func (m *ProfileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProfileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProfileRequest proto.InternalMessageInfo
+
+func (m *ProfileRequest) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *ProfileRequest) GetSeconds() int64 {
+	if m != nil {
+		return m.Seconds
+	}
+	return 0
+}
+
+type ProfileResponse struct {
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProfileResponse) Reset()         { *m = ProfileResponse{} }
+func (m *ProfileResponse) String() string { return proto.CompactTextString(m) }
+func (*ProfileResponse) ProtoMessage()    {}
+func (*ProfileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{29}
+}
+
+func (m *ProfileResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProfileResponse.Unmarshal(m, b)
+}
+func (m *ProfileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProfileResponse.Marshal(b, m, deterministic)
+}
+func (m *ProfileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProfileResponse.Merge(m, src)
+}
+func (m *ProfileResponse) XXX_Size() int {
+	return xxx_messageInfo_ProfileResponse.Size(m)
+}
+func (m *ProfileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProfileResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProfileResponse proto.InternalMessageInfo
+
+func (m *ProfileResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type ResourcesResponse struct {
+	MemStats             *ResourcesResponse_MemStats   `protobuf:"bytes,1,opt,name=mem_stats,json=memStats,proto3" json:"mem_stats"`
+	Goroutines           int64                         `protobuf:"varint,2,opt,name=goroutines,proto3" json:"goroutines"`
+	OpenFds              int64                         `protobuf:"varint,3,opt,name=open_fds,json=openFds,proto3" json:"open_fds"`
+	Uptime               int64                         `protobuf:"varint,4,opt,name=uptime,proto3" json:"uptime"`
+	Databases            []*ResourcesResponse_Database `protobuf:"bytes,5,rep,name=databases,proto3" json:"databases"`
+	DataDir              string                        `protobuf:"bytes,6,opt,name=data_dir,json=dataDir,proto3" json:"data_dir"`
+	DiskFree             int64                         `protobuf:"varint,7,opt,name=disk_free,json=diskFree,proto3" json:"disk_free"`
+	DiskTotal            int64                         `protobuf:"varint,8,opt,name=disk_total,json=diskTotal,proto3" json:"disk_total"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *ResourcesResponse) Reset()         { *m = ResourcesResponse{} }
+func (m *ResourcesResponse) String() string { return proto.CompactTextString(m) }
+func (*ResourcesResponse) ProtoMessage()    {}
+func (*ResourcesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{30}
+}
+
+func (m *ResourcesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResourcesResponse.Unmarshal(m, b)
+}
+func (m *ResourcesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResourcesResponse.Marshal(b, m, deterministic)
+}
+func (m *ResourcesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourcesResponse.Merge(m, src)
+}
+func (m *ResourcesResponse) XXX_Size() int {
+	return xxx_messageInfo_ResourcesResponse.Size(m)
+}
+func (m *ResourcesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourcesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourcesResponse proto.InternalMessageInfo
+
+func (m *ResourcesResponse) GetMemStats() *ResourcesResponse_MemStats {
+	if m != nil {
+		return m.MemStats
+	}
+	return nil
+}
+
+func (m *ResourcesResponse) GetGoroutines() int64 {
+	if m != nil {
+		return m.Goroutines
+	}
+	return 0
+}
+
+func (m *ResourcesResponse) GetOpenFds() int64 {
+	if m != nil {
+		return m.OpenFds
+	}
+	return 0
+}
+
+func (m *ResourcesResponse) GetUptime() int64 {
+	if m != nil {
+		return m.Uptime
+	}
+	return 0
+}
+
+func (m *ResourcesResponse) GetDatabases() []*ResourcesResponse_Database {
+	if m != nil {
+		return m.Databases
+	}
+	return nil
+}
+
+func (m *ResourcesResponse) GetDataDir() string {
+	if m != nil {
+		return m.DataDir
+	}
+	return ""
+}
+
+func (m *ResourcesResponse) GetDiskFree() int64 {
+	if m != nil {
+		return m.DiskFree
+	}
+	return 0
+}
+
+func (m *ResourcesResponse) GetDiskTotal() int64 {
+	if m != nil {
+		return m.DiskTotal
+	}
+	return 0
+}
+
+type ResourcesResponse_MemStats struct {
+	Alloc                uint64   `protobuf:"varint,1,opt,name=alloc,proto3" json:"alloc"`
+	TotalAlloc           uint64   `protobuf:"varint,2,opt,name=total_alloc,json=totalAlloc,proto3" json:"total_alloc"`
+	Sys                  uint64   `protobuf:"varint,3,opt,name=sys,proto3" json:"sys"`
+	HeapAlloc            uint64   `protobuf:"varint,4,opt,name=heap_alloc,json=heapAlloc,proto3" json:"heap_alloc"`
+	HeapInuse            uint64   `protobuf:"varint,5,opt,name=heap_inuse,json=heapInuse,proto3" json:"heap_inuse"`
+	HeapObjects          uint64   `protobuf:"varint,6,opt,name=heap_objects,json=heapObjects,proto3" json:"heap_objects"`
+	StackInuse           uint64   `protobuf:"varint,7,opt,name=stack_inuse,json=stackInuse,proto3" json:"stack_inuse"`
+	NumGc                uint32   `protobuf:"varint,8,opt,name=num_gc,json=numGc,proto3" json:"num_gc"`
+	PauseTotal           int64    `protobuf:"varint,9,opt,name=pause_total,json=pauseTotal,proto3" json:"pause_total"`
+	LastGc               string   `protobuf:"bytes,10,opt,name=last_gc,json=lastGc,proto3" json:"last_gc"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResourcesResponse_MemStats) Reset()         { *m = ResourcesResponse_MemStats{} }
+func (m *ResourcesResponse_MemStats) String() string { return proto.CompactTextString(m) }
+func (*ResourcesResponse_MemStats) ProtoMessage()    {}
+func (*ResourcesResponse_MemStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{30, 0}
+}
+
+func (m *ResourcesResponse_MemStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResourcesResponse_MemStats.Unmarshal(m, b)
+}
+func (m *ResourcesResponse_MemStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResourcesResponse_MemStats.Marshal(b, m, deterministic)
+}
+func (m *ResourcesResponse_MemStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourcesResponse_MemStats.Merge(m, src)
+}
+func (m *ResourcesResponse_MemStats) XXX_Size() int {
+	return xxx_messageInfo_ResourcesResponse_MemStats.Size(m)
+}
+func (m *ResourcesResponse_MemStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourcesResponse_MemStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourcesResponse_MemStats proto.InternalMessageInfo
+
+func (m *ResourcesResponse_MemStats) GetAlloc() uint64 {
+	if m != nil {
+		return m.Alloc
+	}
+	return 0
+}
+
+func (m *ResourcesResponse_MemStats) GetTotalAlloc() uint64 {
+	if m != nil {
+		return m.TotalAlloc
+	}
+	return 0
+}
+
+func (m *ResourcesResponse_MemStats) GetSys() uint64 {
+	if m != nil {
+		return m.Sys
+	}
+	return 0
+}
+
+func (m *ResourcesResponse_MemStats) GetHeapAlloc() uint64 {
+	if m != nil {
+		return m.HeapAlloc
+	}
+	return 0
+}
+
+func (m *ResourcesResponse_MemStats) GetHeapInuse() uint64 {
+	if m != nil {
+		return m.HeapInuse
+	}
+	return 0
+}
+
+func (m *ResourcesResponse_MemStats) GetHeapObjects() uint64 {
+	if m != nil {
+		return m.HeapObjects
+	}
+	return 0
+}
+
+func (m *ResourcesResponse_MemStats) GetStackInuse() uint64 {
+	if m != nil {
+		return m.StackInuse
+	}
+	return 0
+}
+
+func (m *ResourcesResponse_MemStats) GetNumGc() uint32 {
+	if m != nil {
+		return m.NumGc
+	}
+	return 0
+}
+
+func (m *ResourcesResponse_MemStats) GetPauseTotal() int64 {
+	if m != nil {
+		return m.PauseTotal
+	}
+	return 0
+}
+
+func (m *ResourcesResponse_MemStats) GetLastGc() string {
+	if m != nil {
+		return m.LastGc
+	}
+	return ""
+}
+
+type ResourcesResponse_Database struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
+	Size                 int64    `protobuf:"varint,2,opt,name=size,proto3" json:"size"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResourcesResponse_Database) Reset()         { *m = ResourcesResponse_Database{} }
+func (m *ResourcesResponse_Database) String() string { return proto.CompactTextString(m) }
+func (*ResourcesResponse_Database) ProtoMessage()    {}
+func (*ResourcesResponse_Database) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{30, 1}
+}
+
+func (m *ResourcesResponse_Database) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResourcesResponse_Database.Unmarshal(m, b)
+}
+func (m *ResourcesResponse_Database) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResourcesResponse_Database.Marshal(b, m, deterministic)
+}
+func (m *ResourcesResponse_Database) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourcesResponse_Database.Merge(m, src)
+}
+func (m *ResourcesResponse_Database) XXX_Size() int {
+	return xxx_messageInfo_ResourcesResponse_Database.Size(m)
+}
+func (m *ResourcesResponse_Database) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourcesResponse_Database.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourcesResponse_Database proto.InternalMessageInfo
+
+func (m *ResourcesResponse_Database) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ResourcesResponse_Database) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+type HealthRequest struct {
+	MinPeers             int64    `protobuf:"varint,1,opt,name=min_peers,json=minPeers,proto3" json:"min_peers"`
+	MaxBlockAge          int64    `protobuf:"varint,2,opt,name=max_block_age,json=maxBlockAge,proto3" json:"max_block_age"`
+	MinFreeDisk          float64  `protobuf:"fixed64,3,opt,name=min_free_disk,json=minFreeDisk,proto3" json:"min_free_disk"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HealthRequest) Reset()         { *m = HealthRequest{} }
+func (m *HealthRequest) String() string { return proto.CompactTextString(m) }
+func (*HealthRequest) ProtoMessage()    {}
+func (*HealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{31}
+}
+
+func (m *HealthRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HealthRequest.Unmarshal(m, b)
+}
+func (m *HealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HealthRequest.Marshal(b, m, deterministic)
+}
+func (m *HealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HealthRequest.Merge(m, src)
+}
+func (m *HealthRequest) XXX_Size() int {
+	return xxx_messageInfo_HealthRequest.Size(m)
+}
+func (m *HealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HealthRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HealthRequest proto.InternalMessageInfo
+
+func (m *HealthRequest) GetMinPeers() int64 {
+	if m != nil {
+		return m.MinPeers
+	}
+	return 0
+}
+
+func (m *HealthRequest) GetMaxBlockAge() int64 {
+	if m != nil {
+		return m.MaxBlockAge
+	}
+	return 0
+}
+
+func (m *HealthRequest) GetMinFreeDisk() float64 {
+	if m != nil {
+		return m.MinFreeDisk
+	}
+	return 0
+}
+
+type HealthResponse struct {
+	Healthy              bool                    `protobuf:"varint,1,opt,name=healthy,proto3" json:"healthy"`
+	Checks               []*HealthResponse_Check `protobuf:"bytes,2,rep,name=checks,proto3" json:"checks"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *HealthResponse) Reset()         { *m = HealthResponse{} }
+func (m *HealthResponse) String() string { return proto.CompactTextString(m) }
+func (*HealthResponse) ProtoMessage()    {}
+func (*HealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{32}
+}
+
+func (m *HealthResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HealthResponse.Unmarshal(m, b)
+}
+func (m *HealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HealthResponse.Marshal(b, m, deterministic)
+}
+func (m *HealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HealthResponse.Merge(m, src)
+}
+func (m *HealthResponse) XXX_Size() int {
+	return xxx_messageInfo_HealthResponse.Size(m)
+}
+func (m *HealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HealthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HealthResponse proto.InternalMessageInfo
+
+func (m *HealthResponse) GetHealthy() bool {
+	if m != nil {
+		return m.Healthy
+	}
+	return false
+}
+
+func (m *HealthResponse) GetChecks() []*HealthResponse_Check {
+	if m != nil {
+		return m.Checks
+	}
+	return nil
+}
+
+type HealthResponse_Check struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
+	Healthy              bool     `protobuf:"varint,2,opt,name=healthy,proto3" json:"healthy"`
+	Message              string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HealthResponse_Check) Reset()         { *m = HealthResponse_Check{} }
+func (m *HealthResponse_Check) String() string { return proto.CompactTextString(m) }
+func (*HealthResponse_Check) ProtoMessage()    {}
+func (*HealthResponse_Check) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{32, 0}
+}
+
+func (m *HealthResponse_Check) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HealthResponse_Check.Unmarshal(m, b)
+}
+func (m *HealthResponse_Check) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HealthResponse_Check.Marshal(b, m, deterministic)
+}
+func (m *HealthResponse_Check) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HealthResponse_Check.Merge(m, src)
+}
+func (m *HealthResponse_Check) XXX_Size() int {
+	return xxx_messageInfo_HealthResponse_Check.Size(m)
+}
+func (m *HealthResponse_Check) XXX_DiscardUnknown() {
+	xxx_messageInfo_HealthResponse_Check.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HealthResponse_Check proto.InternalMessageInfo
+
+func (m *HealthResponse_Check) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *HealthResponse_Check) GetHealthy() bool {
+	if m != nil {
+		return m.Healthy
+	}
+	return false
+}
+
+func (m *HealthResponse_Check) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type AddressBookResponse struct {
+	Addresses            []*AddressBookResponse_Address `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses"`
+	SavedAt              string                         `protobuf:"bytes,2,opt,name=saved_at,json=savedAt,proto3" json:"saved_at"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *AddressBookResponse) Reset()         { *m = AddressBookResponse{} }
+func (m *AddressBookResponse) String() string { return proto.CompactTextString(m) }
+func (*AddressBookResponse) ProtoMessage()    {}
+func (*AddressBookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{33}
+}
+
+func (m *AddressBookResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddressBookResponse.Unmarshal(m, b)
+}
+func (m *AddressBookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddressBookResponse.Marshal(b, m, deterministic)
+}
+func (m *AddressBookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressBookResponse.Merge(m, src)
+}
+func (m *AddressBookResponse) XXX_Size() int {
+	return xxx_messageInfo_AddressBookResponse.Size(m)
+}
+func (m *AddressBookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressBookResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressBookResponse proto.InternalMessageInfo
+
+func (m *AddressBookResponse) GetAddresses() []*AddressBookResponse_Address {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *AddressBookResponse) GetSavedAt() string {
+	if m != nil {
+		return m.SavedAt
+	}
+	return ""
+}
+
+type AddressBookResponse_Address struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address"`
+	Source               string   `protobuf:"bytes,3,opt,name=source,proto3" json:"source"`
+	Bucket               string   `protobuf:"bytes,4,opt,name=bucket,proto3" json:"bucket"`
+	Attempts             int32    `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts"`
+	LastAttempt          string   `protobuf:"bytes,6,opt,name=last_attempt,json=lastAttempt,proto3" json:"last_attempt"`
+	LastSuccess          string   `protobuf:"bytes,7,opt,name=last_success,json=lastSuccess,proto3" json:"last_success"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddressBookResponse_Address) Reset()         { *m = AddressBookResponse_Address{} }
+func (m *AddressBookResponse_Address) String() string { return proto.CompactTextString(m) }
+func (*AddressBookResponse_Address) ProtoMessage()    {}
+func (*AddressBookResponse_Address) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{33, 0}
+}
+
+func (m *AddressBookResponse_Address) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddressBookResponse_Address.Unmarshal(m, b)
+}
+func (m *AddressBookResponse_Address) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddressBookResponse_Address.Marshal(b, m, deterministic)
+}
+func (m *AddressBookResponse_Address) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressBookResponse_Address.Merge(m, src)
+}
+func (m *AddressBookResponse_Address) XXX_Size() int {
+	return xxx_messageInfo_AddressBookResponse_Address.Size(m)
+}
+func (m *AddressBookResponse_Address) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressBookResponse_Address.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressBookResponse_Address proto.InternalMessageInfo
+
+func (m *AddressBookResponse_Address) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *AddressBookResponse_Address) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AddressBookResponse_Address) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *AddressBookResponse_Address) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+func (m *AddressBookResponse_Address) GetAttempts() int32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *AddressBookResponse_Address) GetLastAttempt() string {
+	if m != nil {
+		return m.LastAttempt
+	}
+	return ""
+}
+
+func (m *AddressBookResponse_Address) GetLastSuccess() string {
+	if m != nil {
+		return m.LastSuccess
+	}
+	return ""
+}
+
+type AddAddressRequest struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddAddressRequest) Reset()         { *m = AddAddressRequest{} }
+func (m *AddAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*AddAddressRequest) ProtoMessage()    {}
+func (*AddAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{34}
+}
+
+func (m *AddAddressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddAddressRequest.Unmarshal(m, b)
+}
+func (m *AddAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddAddressRequest.Marshal(b, m, deterministic)
+}
+func (m *AddAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddAddressRequest.Merge(m, src)
+}
+func (m *AddAddressRequest) XXX_Size() int {
+	return xxx_messageInfo_AddAddressRequest.Size(m)
+}
+func (m *AddAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddAddressRequest proto.InternalMessageInfo
+
+func (m *AddAddressRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type AddressRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddressRequest) Reset()         { *m = AddressRequest{} }
+func (m *AddressRequest) String() string { return proto.CompactTextString(m) }
+func (*AddressRequest) ProtoMessage()    {}
+func (*AddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{35}
+}
+
+func (m *AddressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddressRequest.Unmarshal(m, b)
+}
+func (m *AddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddressRequest.Marshal(b, m, deterministic)
+}
+func (m *AddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressRequest.Merge(m, src)
+}
+func (m *AddressRequest) XXX_Size() int {
+	return xxx_messageInfo_AddressRequest.Size(m)
+}
+func (m *AddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressRequest proto.InternalMessageInfo
+
+func (m *AddressRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*NodeInfo)(nil), "pb.NodeInfo")
+	proto.RegisterType((*NodeInfo_ProtocolVersion)(nil), "pb.NodeInfo.ProtocolVersion")
+	proto.RegisterType((*NodeInfo_Other)(nil), "pb.NodeInfo.Other")
+	proto.RegisterType((*NetInfoResponse)(nil), "pb.NetInfoResponse")
+	proto.RegisterType((*NetInfoResponse_Peer)(nil), "pb.NetInfoResponse.Peer")
+	proto.RegisterType((*NetInfoResponse_Peer_ConnectionStatus)(nil), "pb.NetInfoResponse.Peer.ConnectionStatus")
+	proto.RegisterType((*NetInfoResponse_Peer_ConnectionStatus_Monitor)(nil), "pb.NetInfoResponse.Peer.ConnectionStatus.Monitor")
+	proto.RegisterType((*NetInfoResponse_Peer_ConnectionStatus_Channel)(nil), "pb.NetInfoResponse.Peer.ConnectionStatus.Channel")
+	proto.RegisterType((*StatusResponse)(nil), "pb.StatusResponse")
+	proto.RegisterType((*StatusResponse_TmStatus)(nil), "pb.StatusResponse.TmStatus")
+	proto.RegisterType((*StatusResponse_TmStatus_SyncInfo)(nil), "pb.StatusResponse.TmStatus.SyncInfo")
+	proto.RegisterType((*StatusResponse_TmStatus_ValidatorInfo)(nil), "pb.StatusResponse.TmStatus.ValidatorInfo")
+	proto.RegisterType((*StatusResponse_TmStatus_ValidatorInfo_PubKey)(nil), "pb.StatusResponse.TmStatus.ValidatorInfo.PubKey")
+	proto.RegisterType((*WatchStatusRequest)(nil), "pb.WatchStatusRequest")
+	proto.RegisterType((*PruneBlocksRequest)(nil), "pb.PruneBlocksRequest")
+	proto.RegisterType((*PruneBlocksResponse)(nil), "pb.PruneBlocksResponse")
+	proto.RegisterType((*DealPeerRequest)(nil), "pb.DealPeerRequest")
+	proto.RegisterType((*StopPeerRequest)(nil), "pb.StopPeerRequest")
+	proto.RegisterType((*BanPeerRequest)(nil), "pb.BanPeerRequest")
+	proto.RegisterType((*UnbanPeerRequest)(nil), "pb.UnbanPeerRequest")
+	proto.RegisterType((*BannedPeersResponse)(nil), "pb.BannedPeersResponse")
+	proto.RegisterType((*BannedPeersResponse_Peer)(nil), "pb.BannedPeersResponse.Peer")
+	proto.RegisterType((*AuditLogRequest)(nil), "pb.AuditLogRequest")
+	proto.RegisterType((*AuditLogResponse)(nil), "pb.AuditLogResponse")
+	proto.RegisterType((*AuditLogResponse_Entry)(nil), "pb.AuditLogResponse.Entry")
+	proto.RegisterType((*Transaction)(nil), "pb.Transaction")
+	proto.RegisterType((*UnconfirmedTxsRequest)(nil), "pb.UnconfirmedTxsRequest")
+	proto.RegisterType((*UnconfirmedTxsResponse)(nil), "pb.UnconfirmedTxsResponse")
+	proto.RegisterType((*NumUnconfirmedTxsResponse)(nil), "pb.NumUnconfirmedTxsResponse")
+	proto.RegisterType((*BlockRequest)(nil), "pb.BlockRequest")
+	proto.RegisterType((*BlockResponse)(nil), "pb.BlockResponse")
+	proto.RegisterType((*BlockResponse_Signature)(nil), "pb.BlockResponse.Signature")
+	proto.RegisterType((*Event)(nil), "pb.Event")
+	proto.RegisterType((*Event_Attribute)(nil), "pb.Event.Attribute")
+	proto.RegisterType((*TxResult)(nil), "pb.TxResult")
+	proto.RegisterType((*BlockResultsResponse)(nil), "pb.BlockResultsResponse")
+	proto.RegisterType((*BlockResultsResponse_MinterEvent)(nil), "pb.BlockResultsResponse.MinterEvent")
+	proto.RegisterType((*TxRequest)(nil), "pb.TxRequest")
+	proto.RegisterType((*TxResponse)(nil), "pb.TxResponse")
+	proto.RegisterType((*ValidatorRequest)(nil), "pb.ValidatorRequest")
+	proto.RegisterType((*ValidatorResponse)(nil), "pb.ValidatorResponse")
+	proto.RegisterType((*LogLevelResponse)(nil), "pb.LogLevelResponse")
+	proto.RegisterType((*LogLevelResponse_Module)(nil), "pb.LogLevelResponse.Module")
+	proto.RegisterType((*SetLogLevelRequest)(nil), "pb.SetLogLevelRequest")
+	proto.RegisterType((*ProfileRequest)(nil), "pb.ProfileRequest")
+	proto.RegisterType((*ProfileResponse)(nil), "pb.ProfileResponse")
+	proto.RegisterType((*ResourcesResponse)(nil), "pb.ResourcesResponse")
+	proto.RegisterType((*ResourcesResponse_MemStats)(nil), "pb.ResourcesResponse.MemStats")
+	proto.RegisterType((*ResourcesResponse_Database)(nil), "pb.ResourcesResponse.Database")
+	proto.RegisterType((*HealthRequest)(nil), "pb.HealthRequest")
+	proto.RegisterType((*HealthResponse)(nil), "pb.HealthResponse")
+	proto.RegisterType((*HealthResponse_Check)(nil), "pb.HealthResponse.Check")
+	proto.RegisterType((*AddressBookResponse)(nil), "pb.AddressBookResponse")
+	proto.RegisterType((*AddressBookResponse_Address)(nil), "pb.AddressBookResponse.Address")
+	proto.RegisterType((*AddAddressRequest)(nil), "pb.AddAddressRequest")
+	proto.RegisterType((*AddressRequest)(nil), "pb.AddressRequest")
+}
+
+func init() { proto.RegisterFile("manager.proto", fileDescriptor_cde9ec64f0d2c859) }
+
+var fileDescriptor_cde9ec64f0d2c859 = []byte{
+	// 3423 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xcd, 0x73, 0x1c, 0x49,
+	0x56, 0x8f, 0xee, 0x56, 0x7f, 0xbd, 0x56, 0xb7, 0xa4, 0x94, 0x3c, 0x23, 0xb7, 0x77, 0xd7, 0x72,
+	0xed, 0xec, 0xe2, 0x99, 0x9d, 0xed, 0xf1, 0xca, 0x33, 0x78, 0x59, 0x7b, 0x86, 0x90, 0xfc, 0xb5,
+	0x62, 0xac, 0xb1, 0xa8, 0x96, 0x67, 0x8e, 0x1d, 0xd9, 0x55, 0xa9, 0x56, 0xa1, 0xaa, 0xac, 0xa2,
+	0x32, 0xab, 0xd5, 0xe2, 0xce, 0x99, 0x00, 0x82, 0x80, 0x7f, 0x00, 0x0e, 0x44, 0x6c, 0x70, 0xdc,
+	0xe0, 0xc4, 0x05, 0x4e, 0x04, 0x47, 0x38, 0xc0, 0xff, 0x41, 0x04, 0x37, 0xe2, 0xe5, 0x47, 0x55,
+	0xf5, 0x97, 0xc7, 0x26, 0x82, 0x93, 0xf2, 0x7d, 0xf6, 0xcb, 0x97, 0x99, 0xbf, 0x7c, 0xf9, 0x4a,
+	0xd0, 0x8d, 0x28, 0xa7, 0x13, 0x96, 0x0e, 0x92, 0x34, 0x96, 0x31, 0xa9, 0x26, 0xe3, 0xfe, 0x9d,
+	0x49, 0x1c, 0x4f, 0x42, 0xf6, 0x99, 0xe2, 0x8c, 0xb3, 0x8b, 0xcf, 0x58, 0x94, 0xc8, 0x1b, 0xad,
+	0xe0, 0xfc, 0x7d, 0x0d, 0x5a, 0xdf, 0xc4, 0x3e, 0x3b, 0xe1, 0x17, 0x31, 0x79, 0x09, 0xdb, 0x8a,
+	0xeb, 0xc5, 0xe1, 0x68, 0xca, 0x52, 0x11, 0xc4, 0x7c, 0xbf, 0x75, 0x50, 0xb9, 0xdf, 0x39, 0xfc,
+	0xc1, 0x20, 0x19, 0x0f, 0xac, 0xde, 0xe0, 0xcc, 0x28, 0x7d, 0xab, 0x75, 0xdc, 0xad, 0x64, 0x9e,
+	0x41, 0x7a, 0x50, 0x0d, 0xfc, 0xfd, 0xca, 0x41, 0xe5, 0x7e, 0xdb, 0xad, 0x06, 0x3e, 0xb9, 0x0b,
+	0x9d, 0x30, 0x10, 0x92, 0xf1, 0x11, 0xf5, 0xfd, 0x74, 0xbf, 0xaa, 0x04, 0xa0, 0x59, 0x47, 0xbe,
+	0x9f, 0x92, 0x7d, 0x68, 0x72, 0x26, 0xaf, 0xe3, 0xf4, 0x6a, 0xbf, 0xa6, 0x84, 0x96, 0x44, 0x89,
+	0x0d, 0x65, 0x43, 0x4b, 0x0c, 0x49, 0xfa, 0xd0, 0xf2, 0x2e, 0x29, 0xe7, 0x2c, 0x14, 0xfb, 0x75,
+	0x25, 0xca, 0x69, 0xb4, 0x8a, 0x62, 0x1e, 0x5c, 0xb1, 0x74, 0xbf, 0xa1, 0xad, 0x0c, 0x49, 0xee,
+	0x43, 0x3d, 0x96, 0x97, 0x2c, 0xdd, 0x6f, 0xaa, 0x89, 0x91, 0xb9, 0x89, 0xbd, 0x46, 0x89, 0xab,
+	0x15, 0xfa, 0x5f, 0xc3, 0xd6, 0xc2, 0x44, 0xc9, 0x36, 0xd4, 0x92, 0xc3, 0x44, 0x85, 0xb8, 0xe1,
+	0xe2, 0x90, 0xec, 0x41, 0x7d, 0x1c, 0xc6, 0xde, 0x95, 0x9a, 0xec, 0x86, 0xab, 0x09, 0xd4, 0xa3,
+	0x49, 0xa2, 0xe6, 0xb9, 0xe1, 0xe2, 0xb0, 0xff, 0x14, 0xea, 0xca, 0x39, 0xb9, 0x0d, 0x2d, 0x39,
+	0x1b, 0x05, 0xdc, 0x67, 0x33, 0x93, 0x87, 0xa6, 0x9c, 0x9d, 0x20, 0x89, 0x59, 0x4a, 0x13, 0x4f,
+	0xa5, 0x88, 0x09, 0x61, 0xd2, 0x07, 0x69, 0xe2, 0x1d, 0x69, 0x8e, 0xf3, 0x17, 0x6d, 0xd8, 0xfa,
+	0x86, 0x49, 0x0c, 0xd5, 0x65, 0x22, 0x89, 0xb9, 0x60, 0xe4, 0x07, 0xd0, 0xd6, 0x79, 0x0c, 0xf8,
+	0x44, 0x65, 0xa8, 0xe5, 0x16, 0x8c, 0x42, 0xca, 0x52, 0x74, 0x58, 0xbb, 0xdf, 0x76, 0x0b, 0x06,
+	0xf9, 0x10, 0x9a, 0x7c, 0x94, 0x30, 0x94, 0x61, 0x28, 0x35, 0xb7, 0xc1, 0xcf, 0x90, 0x22, 0x03,
+	0xa8, 0x6b, 0x76, 0xed, 0xa0, 0x76, 0xbf, 0x73, 0xb8, 0xaf, 0x92, 0x34, 0xff, 0xc3, 0x03, 0xd4,
+	0x74, 0xb5, 0x5a, 0xff, 0x7f, 0x9a, 0xb0, 0x81, 0x34, 0xf9, 0x18, 0xda, 0x3c, 0xf6, 0xd9, 0x28,
+	0xe0, 0x17, 0xb1, 0x8a, 0xa6, 0x73, 0xb8, 0x59, 0xce, 0xb0, 0xdb, 0xe2, 0x66, 0x84, 0xb3, 0x0d,
+	0xc4, 0x28, 0xce, 0xe4, 0x38, 0xce, 0xb8, 0xde, 0x2c, 0x2d, 0x17, 0x02, 0xf1, 0xda, 0x70, 0xc8,
+	0xb7, 0xb0, 0xe3, 0xc5, 0x9c, 0x33, 0x4f, 0x06, 0x31, 0x1f, 0x09, 0x49, 0x65, 0xa6, 0xe3, 0xec,
+	0x1c, 0x7e, 0xbc, 0x2e, 0xa0, 0xc1, 0xd3, 0xdc, 0x62, 0xa8, 0x0c, 0xdc, 0x6d, 0x6f, 0x81, 0x43,
+	0xee, 0x40, 0x3b, 0x65, 0x51, 0x2c, 0xd9, 0x28, 0x48, 0xcc, 0x6e, 0x6b, 0x69, 0xc6, 0x49, 0xd2,
+	0xff, 0x87, 0x06, 0x6c, 0x2f, 0xfa, 0xc0, 0x9d, 0xf6, 0x2c, 0x4b, 0xa9, 0xb4, 0x9b, 0xb0, 0xe6,
+	0xe6, 0x34, 0x19, 0x42, 0x67, 0xc8, 0xb8, 0x7f, 0x1a, 0xf3, 0x40, 0xc6, 0xa9, 0x9a, 0x46, 0xe7,
+	0xf0, 0x17, 0xef, 0x1c, 0xdf, 0xc0, 0x18, 0xba, 0x65, 0x2f, 0xe8, 0xd4, 0x65, 0xde, 0xd4, 0x3a,
+	0xad, 0xfe, 0x9f, 0x9d, 0x96, 0xbc, 0x90, 0x53, 0x68, 0x3d, 0xb5, 0xe7, 0x45, 0xaf, 0xeb, 0x7b,
+	0x78, 0x34, 0x96, 0x6e, 0xee, 0xa2, 0xff, 0xef, 0x55, 0x68, 0x5a, 0xd7, 0x1f, 0x40, 0xe3, 0xc8,
+	0x93, 0xc1, 0x94, 0xed, 0x77, 0xd5, 0x32, 0x1a, 0x0a, 0x4f, 0xc7, 0x50, 0xd2, 0x54, 0x9a, 0xbd,
+	0xac, 0x89, 0xb9, 0x74, 0x56, 0x17, 0xd2, 0x49, 0x60, 0xe3, 0xc4, 0x0f, 0x99, 0x5a, 0x97, 0x9a,
+	0xab, 0xc6, 0xe8, 0xe5, 0xf8, 0x46, 0x32, 0x61, 0x72, 0xaf, 0x09, 0x3c, 0xe2, 0x43, 0x1a, 0x25,
+	0x21, 0xd3, 0xa7, 0xbf, 0xe6, 0x5a, 0x12, 0xfd, 0x9f, 0x70, 0x21, 0x5d, 0x2a, 0x99, 0x3a, 0xfd,
+	0x35, 0x37, 0xa7, 0xd1, 0xea, 0x69, 0x96, 0x2a, 0x51, 0x53, 0x5b, 0x19, 0x12, 0x25, 0x47, 0xd3,
+	0x89, 0x92, 0xb4, 0xb4, 0xc4, 0x90, 0xe8, 0xef, 0x8c, 0xd1, 0x2b, 0x25, 0x6a, 0x6b, 0x7f, 0x96,
+	0x46, 0x99, 0x0a, 0xc7, 0x65, 0xd1, 0x3e, 0x68, 0x99, 0xa5, 0xd1, 0xe3, 0x79, 0x10, 0x31, 0x14,
+	0x75, 0xb4, 0x47, 0x43, 0x2a, 0x8f, 0x69, 0x3c, 0x51, 0xc7, 0x7c, 0xf3, 0xa0, 0x72, 0xbf, 0xeb,
+	0xe6, 0x74, 0xff, 0x37, 0x15, 0x68, 0x9a, 0x24, 0x23, 0x8e, 0x9e, 0x3c, 0x53, 0xd3, 0xab, 0xbb,
+	0xd5, 0x93, 0x67, 0xe4, 0x53, 0xd8, 0xc1, 0x6d, 0xf2, 0x87, 0x19, 0xcb, 0xd8, 0x53, 0x9a, 0x50,
+	0x2f, 0x90, 0x37, 0x2a, 0xb7, 0x35, 0x77, 0x59, 0x40, 0x3e, 0x82, 0x6e, 0xce, 0x1c, 0x06, 0x7f,
+	0xc2, 0x4c, 0xb2, 0xe7, 0x99, 0x3a, 0x96, 0x20, 0x4e, 0xd1, 0x55, 0xcd, 0xcc, 0xce, 0xd0, 0xc4,
+	0x81, 0x4d, 0x97, 0x79, 0x8c, 0xcb, 0xf0, 0x66, 0xc8, 0xb8, 0x34, 0x0b, 0x30, 0xc7, 0x73, 0x7e,
+	0xdb, 0x84, 0x9e, 0x39, 0x6b, 0x16, 0x93, 0x4a, 0x98, 0xdd, 0x9c, 0xc7, 0xec, 0x4f, 0x60, 0x27,
+	0xa4, 0x92, 0x09, 0x39, 0x52, 0x40, 0x39, 0xba, 0xa4, 0xe2, 0xd2, 0x6c, 0x8e, 0x2d, 0x2d, 0x38,
+	0x46, 0xfe, 0xaf, 0xa9, 0xb8, 0x24, 0x3f, 0x05, 0xc3, 0x1a, 0xd1, 0x24, 0xd1, 0x9a, 0x1a, 0x30,
+	0xbb, 0x9a, 0x7d, 0x94, 0x24, 0x4a, 0x6f, 0x00, 0xbb, 0xf3, 0x3e, 0x59, 0x30, 0xb9, 0x94, 0x66,
+	0x2e, 0x3b, 0x65, 0xaf, 0x4a, 0xb0, 0x14, 0x83, 0x0c, 0x22, 0x66, 0xee, 0x96, 0x72, 0x0c, 0xb8,
+	0x56, 0xe4, 0x3e, 0x6c, 0x5f, 0x31, 0x96, 0x8c, 0x42, 0x2a, 0xa4, 0x82, 0xa0, 0x7c, 0xb7, 0xf5,
+	0x90, 0xff, 0x8a, 0x0a, 0x39, 0x54, 0x5c, 0xf2, 0x4b, 0x68, 0xcb, 0xc8, 0xa2, 0x54, 0x43, 0x1d,
+	0xd8, 0x3b, 0x78, 0xbc, 0xe6, 0x53, 0x33, 0x38, 0x8f, 0x0c, 0xa3, 0x25, 0xcd, 0xa8, 0xff, 0xdf,
+	0x1b, 0xd0, 0xb2, 0xec, 0x79, 0x00, 0xad, 0xbd, 0x15, 0x40, 0x8f, 0xa0, 0x2d, 0x6e, 0xb8, 0xa7,
+	0x55, 0x35, 0xee, 0x7c, 0xf4, 0x96, 0x5f, 0x1c, 0x0c, 0x6f, 0xb8, 0xa7, 0x5d, 0x08, 0x33, 0x22,
+	0x67, 0xd0, 0x9b, 0xd2, 0x30, 0xf0, 0xa9, 0x8c, 0x53, 0xed, 0xa7, 0x84, 0xaf, 0xeb, 0xfc, 0x7c,
+	0x6b, 0x2d, 0x94, 0xb3, 0xee, 0xb4, 0x4c, 0xf6, 0xff, 0xab, 0x02, 0x2d, 0xfb, 0x43, 0xab, 0x57,
+	0xbb, 0xfe, 0xce, 0xab, 0x5d, 0x79, 0x8f, 0xd5, 0xae, 0xbe, 0xd7, 0x6a, 0xd7, 0x56, 0xaf, 0xf6,
+	0x5d, 0xe8, 0x78, 0x54, 0x7a, 0x97, 0x01, 0x9f, 0x8c, 0xb2, 0xc4, 0xdc, 0xa6, 0x60, 0x59, 0x6f,
+	0x92, 0xfe, 0xbf, 0x56, 0xa0, 0x3b, 0x37, 0x7d, 0xdc, 0xea, 0xf6, 0xbe, 0x36, 0x85, 0x8b, 0x21,
+	0xc9, 0x09, 0x34, 0x93, 0x6c, 0x3c, 0xba, 0x62, 0x37, 0x66, 0x71, 0x1e, 0xbc, 0x73, 0x52, 0x07,
+	0x67, 0xd9, 0xf8, 0x6b, 0x76, 0xe3, 0x36, 0x12, 0xf5, 0x97, 0xdc, 0x83, 0xcd, 0x69, 0x2c, 0x31,
+	0xaa, 0x24, 0xbe, 0x66, 0xa9, 0x99, 0x6c, 0x47, 0xf3, 0xce, 0x90, 0xd5, 0x3f, 0x84, 0x86, 0x36,
+	0x42, 0x04, 0x95, 0x37, 0x09, 0x33, 0x67, 0x45, 0x8d, 0x11, 0x41, 0xa7, 0x34, 0xcc, 0x98, 0xc5,
+	0x61, 0x45, 0x38, 0x0f, 0x80, 0x7c, 0x87, 0x73, 0xb3, 0x31, 0xfd, 0x71, 0xc6, 0x84, 0x42, 0xe7,
+	0x80, 0x4b, 0x96, 0x4e, 0x69, 0x68, 0xa0, 0x25, 0xa7, 0x1d, 0x17, 0xc8, 0x59, 0x9a, 0x71, 0xa6,
+	0x52, 0x96, 0x5b, 0xdc, 0x85, 0xce, 0x45, 0x1a, 0x47, 0x76, 0x29, 0xb4, 0x11, 0x20, 0xcb, 0xac,
+	0xc1, 0x1d, 0x68, 0xcb, 0x78, 0x7e, 0xa5, 0x5a, 0x32, 0xd6, 0x42, 0xe7, 0x6f, 0x2a, 0xb0, 0x3b,
+	0xe7, 0xd4, 0x80, 0xc8, 0x1e, 0xd4, 0x65, 0x2c, 0xf3, 0x20, 0x34, 0x81, 0xf9, 0xf6, 0xb2, 0x34,
+	0x65, 0xdc, 0x3a, 0xb2, 0x24, 0xde, 0x41, 0x73, 0x27, 0xdf, 0x50, 0xe4, 0x77, 0x60, 0x6b, 0x8c,
+	0x88, 0x3c, 0x4a, 0x99, 0x17, 0xd2, 0x20, 0x62, 0xbe, 0x81, 0xb1, 0xde, 0x58, 0x03, 0xb5, 0xe1,
+	0x62, 0xd1, 0xc6, 0x24, 0x35, 0xc7, 0x1b, 0x87, 0xce, 0xd7, 0xb0, 0xf5, 0x8c, 0xd1, 0x50, 0x55,
+	0x3a, 0x66, 0xae, 0xa5, 0xf5, 0xae, 0xcc, 0xaf, 0xf7, 0x8f, 0x00, 0x12, 0x44, 0x39, 0x21, 0x6d,
+	0x70, 0x2d, 0xb7, 0xc4, 0x71, 0xee, 0xc1, 0xd6, 0x50, 0xc6, 0x49, 0xd9, 0xd9, 0x42, 0x99, 0xec,
+	0x3c, 0x81, 0xde, 0x31, 0xe5, 0x6f, 0xd1, 0xc0, 0xc5, 0xf1, 0x17, 0xae, 0x4e, 0x4b, 0x3b, 0x0e,
+	0x6c, 0xbf, 0xe1, 0xe3, 0xb7, 0xda, 0x3b, 0xd7, 0xb0, 0x7b, 0x8c, 0x57, 0x8b, 0x8f, 0x4a, 0x45,
+	0xae, 0x0f, 0x6d, 0xbd, 0x57, 0x39, 0xa8, 0xd9, 0x6a, 0x7f, 0x85, 0xde, 0x5c, 0xcd, 0xf7, 0xa9,
+	0x29, 0xf9, 0x16, 0x43, 0xdc, 0x83, 0x7a, 0xc6, 0x65, 0x10, 0x9a, 0x0d, 0xa8, 0x09, 0xe7, 0xf7,
+	0x61, 0xeb, 0x28, 0xf3, 0x03, 0xf9, 0x2a, 0x9e, 0xd8, 0xd8, 0xf6, 0xa0, 0x2e, 0x02, 0xee, 0xe5,
+	0x9b, 0x52, 0x11, 0xb8, 0x8c, 0x11, 0x93, 0x97, 0xb1, 0x6f, 0xec, 0x0d, 0xe5, 0xfc, 0x59, 0x15,
+	0xb6, 0x0b, 0x0f, 0x26, 0xee, 0xcf, 0xa1, 0xc9, 0xb8, 0x4c, 0x03, 0x66, 0x23, 0xef, 0x63, 0xe4,
+	0x8b, 0x6a, 0x83, 0xe7, 0x5c, 0xa6, 0x37, 0xae, 0x55, 0xed, 0xff, 0x73, 0x05, 0xea, 0x8a, 0xa5,
+	0xce, 0x4a, 0x10, 0xd9, 0x08, 0xd4, 0x18, 0x03, 0xf0, 0x68, 0x18, 0x32, 0xfb, 0x4c, 0x31, 0x54,
+	0x29, 0xb0, 0x5a, 0x39, 0x30, 0xdc, 0x11, 0xa9, 0x9e, 0x91, 0x7d, 0xa0, 0x18, 0x12, 0xbd, 0x7b,
+	0xb1, 0xcf, 0x0c, 0xe2, 0xa9, 0x31, 0x6a, 0x23, 0xea, 0x70, 0xef, 0xc6, 0x94, 0x26, 0x96, 0xc4,
+	0x43, 0x92, 0xa4, 0x6c, 0xaa, 0xa1, 0x4f, 0x5f, 0x9b, 0x2d, 0x64, 0x28, 0xd4, 0x23, 0xb0, 0xa1,
+	0xf8, 0x2d, 0xed, 0x0a, 0xc7, 0xce, 0x7f, 0x56, 0xa0, 0x73, 0x9e, 0x52, 0x2e, 0xa8, 0x67, 0x4b,
+	0xa7, 0x12, 0x6c, 0xaa, 0x31, 0xb9, 0x05, 0x8d, 0x94, 0x5e, 0x8f, 0xa4, 0x7d, 0x6b, 0xd4, 0x53,
+	0x7a, 0x7d, 0x3e, 0x43, 0x55, 0x3c, 0x9e, 0x66, 0x26, 0x6a, 0x8c, 0xcb, 0xc1, 0x63, 0x5c, 0x8e,
+	0x0d, 0xfd, 0x92, 0x51, 0x04, 0x46, 0x35, 0xa1, 0x62, 0x94, 0xa4, 0x81, 0xa7, 0x27, 0xd2, 0x75,
+	0x5b, 0x13, 0x2a, 0xce, 0x90, 0xce, 0xa1, 0xa6, 0x51, 0x82, 0x9a, 0xdb, 0x80, 0xf2, 0x91, 0x17,
+	0x07, 0xf9, 0xe5, 0x3f, 0xa1, 0xe2, 0x69, 0x1c, 0xa8, 0xd7, 0xd3, 0x84, 0x0a, 0x53, 0x5d, 0xe1,
+	0x10, 0xb3, 0x91, 0xd0, 0x9b, 0x30, 0xa6, 0xbe, 0x2a, 0xac, 0x36, 0x5d, 0x4b, 0x3a, 0xcf, 0xe1,
+	0xd6, 0x1b, 0xee, 0xc5, 0xfc, 0x22, 0x48, 0x23, 0xe6, 0x9f, 0xcf, 0x44, 0x69, 0xd7, 0x84, 0x41,
+	0x14, 0x58, 0x98, 0xd1, 0x04, 0x2e, 0x8e, 0x60, 0xdc, 0x2f, 0x16, 0x4d, 0x53, 0xce, 0xdf, 0x56,
+	0xe0, 0x83, 0x45, 0x3f, 0x66, 0xef, 0xec, 0x42, 0x9d, 0x8f, 0xe4, 0x4c, 0x18, 0x47, 0x1b, 0xfc,
+	0x7c, 0x26, 0x0a, 0xd0, 0xa9, 0x96, 0x41, 0xe7, 0x2e, 0x74, 0xd4, 0x60, 0xa4, 0x10, 0xc3, 0xe0,
+	0x0b, 0x28, 0x96, 0xae, 0x45, 0xef, 0x41, 0x0d, 0x3d, 0x6d, 0xa8, 0x3d, 0xb8, 0x85, 0x7b, 0xb0,
+	0xb4, 0x30, 0x2e, 0xca, 0xf0, 0x25, 0x26, 0xd3, 0x8c, 0x7b, 0x54, 0x32, 0x5f, 0x25, 0xb2, 0xe5,
+	0x16, 0x0c, 0x67, 0x02, 0xb7, 0xbf, 0xc9, 0xa2, 0xff, 0xff, 0x48, 0x9d, 0x9f, 0xc2, 0xa6, 0xc2,
+	0x59, 0x9b, 0xce, 0x02, 0x35, 0x2b, 0x65, 0xd4, 0x74, 0xfe, 0xb1, 0x06, 0x5d, 0xa3, 0x68, 0xa2,
+	0x58, 0xb5, 0xbd, 0x0a, 0xeb, 0x6a, 0xd9, 0x3a, 0x3f, 0x57, 0xb5, 0xd2, 0xb9, 0xc2, 0xc7, 0x66,
+	0x16, 0x8d, 0x74, 0x9e, 0x94, 0x32, 0xcf, 0x22, 0x9c, 0x49, 0x1f, 0x5a, 0x49, 0x1a, 0x27, 0xb1,
+	0x60, 0xa9, 0x7d, 0xc7, 0x5b, 0x9a, 0x3c, 0x84, 0x4d, 0x59, 0x64, 0x12, 0x0b, 0xab, 0x95, 0x19,
+	0x9e, 0x53, 0x22, 0x8f, 0x01, 0x44, 0x30, 0xe1, 0x54, 0x66, 0x29, 0x13, 0xfb, 0xcd, 0x83, 0x9a,
+	0xad, 0xc5, 0xe6, 0x26, 0x34, 0x18, 0x5a, 0x1d, 0xb7, 0xa4, 0x4e, 0x7e, 0x0e, 0x24, 0x0a, 0x84,
+	0xc0, 0xcb, 0xb6, 0xe4, 0x44, 0xef, 0xd9, 0x1d, 0x23, 0xc9, 0x2d, 0x45, 0xff, 0xcf, 0x2b, 0xd0,
+	0xce, 0x49, 0xf2, 0x33, 0xd8, 0x29, 0xea, 0xa9, 0xf9, 0x7b, 0x62, 0x3b, 0x17, 0x98, 0xd7, 0xfc,
+	0x3b, 0xdc, 0xea, 0x6a, 0x5b, 0x07, 0x13, 0xce, 0x34, 0xe6, 0xb4, 0x5c, 0x43, 0xe1, 0x66, 0xca,
+	0x83, 0x33, 0xa8, 0x53, 0x30, 0x9c, 0x3f, 0x45, 0x7c, 0x9b, 0x32, 0xae, 0xd7, 0x01, 0x0f, 0x68,
+	0xa5, 0x74, 0x40, 0x1f, 0x02, 0x50, 0x29, 0xd3, 0x60, 0x9c, 0xe1, 0x0e, 0xa9, 0xaa, 0xec, 0xec,
+	0x62, 0x76, 0x94, 0xc9, 0xe0, 0xc8, 0xca, 0xdc, 0x92, 0x5a, 0xff, 0x21, 0xb4, 0x73, 0x01, 0x9e,
+	0x63, 0x5b, 0xd5, 0xb4, 0x5d, 0x1c, 0x16, 0xf5, 0x45, 0xb5, 0x5c, 0x5f, 0xfc, 0x53, 0x05, 0x5a,
+	0xe7, 0x33, 0x97, 0x89, 0x2c, 0x2c, 0xc0, 0xb0, 0xa2, 0x30, 0x44, 0x8d, 0xd1, 0x51, 0x18, 0x4f,
+	0x8c, 0x11, 0x0e, 0x51, 0x2b, 0xaf, 0x7c, 0xdb, 0xae, 0x1a, 0x93, 0x1f, 0x02, 0x20, 0xa2, 0x5c,
+	0x53, 0x2e, 0xf3, 0xbb, 0x1b, 0x41, 0xe9, 0x3b, 0xc5, 0xb0, 0x80, 0x93, 0x09, 0x73, 0xae, 0x6a,
+	0x0a, 0x70, 0xde, 0x08, 0xe6, 0x93, 0x7b, 0xd0, 0x60, 0x38, 0x29, 0xbb, 0x6f, 0xda, 0xf9, 0x34,
+	0x5d, 0x23, 0xc0, 0x4c, 0x62, 0x28, 0x22, 0xa1, 0x1e, 0x33, 0x78, 0x55, 0x30, 0x9c, 0x7f, 0xab,
+	0xc2, 0x9e, 0xdd, 0x34, 0x59, 0x28, 0x8b, 0x23, 0xb9, 0xe6, 0xd8, 0x90, 0x9f, 0x01, 0xf8, 0x2c,
+	0x0c, 0xa6, 0x2c, 0xd5, 0x98, 0x5b, 0xb3, 0xf5, 0xbb, 0xcd, 0x83, 0xdb, 0x36, 0xf2, 0xf3, 0x19,
+	0x79, 0x04, 0x64, 0xcc, 0x26, 0x01, 0x37, 0x95, 0xa9, 0x09, 0xb5, 0xb6, 0x18, 0xea, 0xb6, 0x52,
+	0x52, 0x61, 0x3c, 0xd7, 0x41, 0x3f, 0x84, 0x6d, 0xc6, 0xfd, 0x79, 0xb3, 0x8d, 0x45, 0xb3, 0x1e,
+	0xe3, 0x7e, 0xd9, 0xe8, 0x04, 0xba, 0x91, 0x2a, 0xe4, 0xac, 0x45, 0xfd, 0xa0, 0x66, 0x9f, 0x0c,
+	0xab, 0xe6, 0x38, 0x38, 0x55, 0xda, 0xda, 0xd9, 0x66, 0x54, 0x10, 0xa2, 0xff, 0x08, 0x3a, 0x25,
+	0xe1, 0xca, 0x5d, 0xb6, 0x7a, 0x47, 0xdc, 0x85, 0xf6, 0xf9, 0xcc, 0x42, 0xcf, 0x0a, 0x40, 0x71,
+	0xfe, 0xb2, 0x02, 0x70, 0x3e, 0xb3, 0x21, 0xac, 0x4d, 0xf3, 0x1e, 0xd4, 0x8b, 0x0e, 0x5a, 0xd7,
+	0xd5, 0x04, 0xf9, 0x05, 0x74, 0x4a, 0x38, 0x60, 0x5e, 0x4f, 0x4b, 0x58, 0x51, 0xd6, 0x21, 0x1f,
+	0x41, 0x23, 0x55, 0xd3, 0x2e, 0x37, 0xab, 0xf2, 0xb5, 0x32, 0x32, 0xe7, 0x13, 0xd8, 0xce, 0xeb,
+	0xf3, 0x12, 0x70, 0xaa, 0xfc, 0x5b, 0x54, 0x36, 0x94, 0xf3, 0xd7, 0x1b, 0xb0, 0x53, 0x52, 0x36,
+	0x13, 0xf9, 0x70, 0xfe, 0x31, 0xd0, 0xce, 0x4b, 0xfb, 0x52, 0x3d, 0x59, 0x9d, 0xaf, 0x27, 0x71,
+	0x67, 0x52, 0xee, 0xa3, 0x23, 0x66, 0x8e, 0x7f, 0xc1, 0x20, 0x1f, 0xc3, 0x76, 0x4e, 0xd8, 0x57,
+	0xa7, 0x79, 0xc3, 0xe6, 0x7c, 0xf3, 0xa4, 0xfc, 0x00, 0x1a, 0x54, 0x37, 0x67, 0xf4, 0xb5, 0x63,
+	0xa8, 0x25, 0xfc, 0x69, 0x2c, 0xe3, 0x4f, 0x7e, 0x9d, 0x08, 0x49, 0xaf, 0xec, 0xf9, 0xd0, 0xd7,
+	0xc9, 0x10, 0x39, 0x58, 0xf4, 0x7a, 0x71, 0xa4, 0x60, 0xd1, 0xf4, 0x8a, 0xbb, 0x6e, 0x89, 0x43,
+	0x7e, 0x0c, 0xdd, 0xf8, 0x9a, 0xb3, 0x02, 0x0c, 0xdb, 0xca, 0xc5, 0xa6, 0x62, 0x5a, 0x20, 0xfc,
+	0x09, 0xf4, 0x52, 0x76, 0x4d, 0x53, 0x3f, 0xd7, 0x02, 0xfd, 0xf2, 0xd3, 0x5c, 0xab, 0x56, 0x64,
+	0xbc, 0x53, 0xce, 0x38, 0xfe, 0x86, 0x86, 0xc5, 0x91, 0x11, 0x6f, 0xea, 0x2e, 0x85, 0x66, 0x1e,
+	0xe7, 0x4a, 0x18, 0x53, 0xa1, 0xd4, 0xd5, 0x4a, 0x9a, 0x69, 0x94, 0x3e, 0x81, 0x9d, 0x88, 0xce,
+	0x46, 0xf3, 0x8a, 0x3d, 0xa5, 0xb8, 0x15, 0xd1, 0xd9, 0x69, 0x59, 0xf7, 0x01, 0xec, 0xcd, 0xe9,
+	0x8d, 0xae, 0x03, 0xee, 0xc7, 0xd7, 0xfb, 0x5b, 0x4a, 0x9d, 0x94, 0xfd, 0x7e, 0xa7, 0x24, 0xce,
+	0x6f, 0x2a, 0xb0, 0xfd, 0x2a, 0x9e, 0xbc, 0x62, 0x53, 0x16, 0x96, 0x5f, 0x39, 0x21, 0x32, 0x6c,
+	0x11, 0xac, 0x08, 0xf2, 0x05, 0xb6, 0xaf, 0xfd, 0x2c, 0xcc, 0x01, 0x5a, 0x5d, 0x5f, 0x8b, 0xc6,
+	0x83, 0x53, 0xa5, 0xe3, 0x5a, 0xdd, 0xfe, 0x2b, 0x68, 0x68, 0x96, 0x2a, 0x56, 0xd5, 0xc8, 0x6e,
+	0x37, 0x4d, 0x15, 0x3f, 0x57, 0x2d, 0xff, 0x5c, 0x5e, 0xb2, 0xd7, 0xca, 0x25, 0xfb, 0x13, 0x20,
+	0x43, 0x26, 0x8b, 0x1f, 0x2d, 0xea, 0xaf, 0xe5, 0x80, 0xb7, 0xa1, 0x26, 0xa5, 0xad, 0x45, 0x70,
+	0xe8, 0x7c, 0x05, 0xbd, 0xb3, 0x34, 0xbe, 0x08, 0x42, 0x56, 0x3a, 0xef, 0x57, 0x01, 0xb7, 0x4f,
+	0x05, 0x35, 0xc6, 0xed, 0x2f, 0x98, 0x17, 0x73, 0xdf, 0x76, 0xa0, 0x2d, 0xe9, 0xfc, 0x04, 0xb6,
+	0x72, 0xfb, 0xa2, 0x02, 0xf1, 0xa9, 0xa4, 0xca, 0xc1, 0xa6, 0xab, 0xc6, 0xce, 0x5f, 0xd5, 0x61,
+	0xc7, 0x65, 0x22, 0xce, 0x52, 0x8f, 0x15, 0xf0, 0xfc, 0x18, 0xda, 0x11, 0xd3, 0xdd, 0x18, 0x61,
+	0x5e, 0xdf, 0x3f, 0xc2, 0x0c, 0x2e, 0x69, 0x0e, 0x4e, 0x99, 0x7a, 0x81, 0x0b, 0xb7, 0x15, 0x99,
+	0x11, 0xee, 0xe9, 0x49, 0x9c, 0xc6, 0x99, 0x0c, 0x38, 0xb3, 0x61, 0x95, 0x38, 0x78, 0xe1, 0xc4,
+	0x09, 0xe3, 0xa3, 0x0b, 0xdf, 0x16, 0x58, 0x4d, 0xa4, 0x5f, 0xf8, 0x6a, 0x8b, 0x66, 0x49, 0xde,
+	0x4f, 0xaa, 0xb9, 0x86, 0x22, 0x4f, 0xa0, 0x8d, 0xd1, 0x8e, 0xa9, 0x60, 0x16, 0x77, 0xd7, 0xc4,
+	0xf3, 0xcc, 0xa8, 0xb9, 0x85, 0x01, 0xfe, 0x20, 0x12, 0x23, 0x3f, 0xc8, 0xbf, 0x66, 0x20, 0xfd,
+	0x2c, 0x48, 0xb1, 0x3c, 0xf7, 0x03, 0x71, 0x35, 0xba, 0x48, 0x99, 0x6d, 0x68, 0xb6, 0x90, 0xf1,
+	0x22, 0x65, 0x0c, 0x2f, 0x4e, 0x25, 0xd4, 0x75, 0xa2, 0x2e, 0x61, 0x94, 0xfa, 0x39, 0x32, 0xfa,
+	0x7f, 0x57, 0x85, 0x96, 0x9d, 0x3e, 0x2e, 0x2b, 0x0d, 0xc3, 0xd8, 0xb3, 0xdf, 0x31, 0x14, 0x51,
+	0x9c, 0x7f, 0x2d, 0xd3, 0xdf, 0x33, 0xf4, 0xf9, 0x3f, 0x52, 0x0a, 0xdb, 0x50, 0x13, 0x37, 0xc2,
+	0x7e, 0x10, 0x11, 0x37, 0x02, 0x7f, 0xf4, 0x92, 0xd1, 0xc4, 0x58, 0xe8, 0xb7, 0x44, 0x1b, 0x39,
+	0xda, 0xc0, 0x8a, 0x03, 0x9e, 0x09, 0x0d, 0x48, 0x46, 0x7c, 0x82, 0x0c, 0xc4, 0x24, 0x25, 0x8e,
+	0xc7, 0x7f, 0xc4, 0x3c, 0xa9, 0x1b, 0x69, 0x1b, 0x6e, 0x07, 0x79, 0xaf, 0x35, 0x0b, 0x63, 0x12,
+	0x92, 0x7a, 0x57, 0xc6, 0x45, 0x53, 0xc7, 0xa4, 0x58, 0xda, 0xc7, 0x2d, 0xc0, 0xca, 0x72, 0x34,
+	0xf1, 0x0c, 0x1e, 0xd5, 0x79, 0x16, 0xbd, 0x54, 0x73, 0x49, 0x68, 0x26, 0x98, 0x49, 0x87, 0x6e,
+	0xe4, 0x82, 0x62, 0xa9, 0x7c, 0x20, 0x46, 0xab, 0x36, 0xdf, 0xc4, 0x33, 0xf8, 0xd3, 0x40, 0xf2,
+	0xa5, 0xd7, 0x3f, 0x84, 0x96, 0x5d, 0x16, 0xdc, 0x83, 0x9c, 0x16, 0x2f, 0x46, 0x1c, 0x23, 0x4f,
+	0x14, 0xed, 0x55, 0x35, 0x76, 0x24, 0x74, 0x7f, 0xcd, 0x68, 0x28, 0x2f, 0xed, 0xee, 0xbf, 0x03,
+	0xed, 0x28, 0xb0, 0x5f, 0x5b, 0x4c, 0x5f, 0x25, 0x0a, 0xcc, 0xf7, 0x16, 0x07, 0xbf, 0xdb, 0xcd,
+	0xcc, 0x85, 0x4e, 0x27, 0xd6, 0x55, 0x27, 0xa2, 0x33, 0x05, 0x21, 0x47, 0x13, 0xa6, 0x74, 0x02,
+	0xae, 0x56, 0x7a, 0x84, 0x8b, 0xa8, 0x92, 0x5e, 0x71, 0x3b, 0x51, 0xc0, 0x71, 0xb5, 0x9f, 0x05,
+	0xe2, 0x0a, 0x21, 0xa6, 0x67, 0x7f, 0xb6, 0xe8, 0xc5, 0x5e, 0x2a, 0xce, 0x8d, 0xf9, 0xc4, 0x62,
+	0x49, 0xf2, 0x00, 0x1a, 0xde, 0x25, 0xf3, 0xae, 0x2c, 0xc6, 0xa8, 0xaf, 0x3c, 0xf3, 0xd6, 0x83,
+	0xa7, 0xa8, 0xe0, 0x1a, 0xbd, 0xfe, 0x6b, 0xa8, 0x2b, 0xc6, 0xca, 0x2c, 0x94, 0x7e, 0xa8, 0x3a,
+	0xff, 0x43, 0xf8, 0x31, 0x8e, 0x09, 0x81, 0xf3, 0x32, 0x3d, 0x32, 0x43, 0x3a, 0xbf, 0xad, 0xc2,
+	0xae, 0x81, 0xf7, 0xe3, 0x38, 0x2e, 0xde, 0x1a, 0x5f, 0x42, 0xdb, 0x5c, 0x05, 0xf9, 0xcb, 0xfe,
+	0xae, 0x7a, 0xd9, 0x2f, 0xeb, 0x5a, 0x9e, 0x5b, 0x58, 0xe0, 0x81, 0x11, 0x74, 0xca, 0xfc, 0x11,
+	0x95, 0xf6, 0x56, 0x55, 0xf4, 0x91, 0xec, 0xff, 0x4b, 0x05, 0x9a, 0xc6, 0x62, 0xa9, 0x73, 0xb1,
+	0xfe, 0x2e, 0xc6, 0x3a, 0x5c, 0x1d, 0x54, 0xfb, 0xf6, 0xd7, 0x14, 0xf2, 0xc7, 0x99, 0x77, 0xc5,
+	0xec, 0xd3, 0xdf, 0x50, 0xf8, 0xa4, 0xa1, 0x52, 0xe2, 0x77, 0x56, 0x61, 0xba, 0xf7, 0x39, 0x8d,
+	0x5b, 0x5c, 0x6d, 0x33, 0xc3, 0x30, 0x27, 0xba, 0x83, 0xbc, 0x23, 0xcd, 0xca, 0x55, 0x44, 0xe6,
+	0x79, 0x18, 0x4d, 0xb3, 0x50, 0x19, 0x6a, 0x96, 0xf3, 0x73, 0xd8, 0x39, 0xf2, 0xed, 0xd5, 0xf8,
+	0xbd, 0xcd, 0x29, 0xe7, 0x00, 0x7a, 0x0b, 0xba, 0x0b, 0x93, 0x3f, 0xfc, 0x8f, 0x2e, 0xf4, 0x4e,
+	0xf5, 0xb7, 0xe3, 0x21, 0x4b, 0xa7, 0xf8, 0xbc, 0xff, 0x1c, 0x1a, 0xb6, 0x84, 0x18, 0xe8, 0x6f,
+	0xc8, 0x03, 0xfb, 0x0d, 0x79, 0xf0, 0x1c, 0xbf, 0x21, 0xf7, 0xc9, 0x72, 0x4b, 0x93, 0x3c, 0x86,
+	0x4e, 0xa9, 0xab, 0x48, 0x3e, 0x40, 0x95, 0xe5, 0x36, 0xe3, 0x2a, 0xd3, 0x07, 0x15, 0xf2, 0xbb,
+	0xd0, 0x34, 0xdf, 0xa3, 0xd6, 0xfe, 0xe6, 0xee, 0x8a, 0x8f, 0x56, 0xe4, 0x4b, 0xe8, 0x94, 0x7a,
+	0x88, 0xfa, 0x47, 0x97, 0x3b, 0x95, 0xfd, 0x35, 0x3e, 0xc9, 0x0b, 0xd8, 0x29, 0x69, 0x0f, 0x65,
+	0xca, 0x68, 0xb4, 0xd6, 0xc9, 0x87, 0x4b, 0xfc, 0x3c, 0xfc, 0x47, 0xd0, 0xb2, 0x0d, 0x43, 0xa2,
+	0xe2, 0x5c, 0x68, 0x1f, 0xae, 0x0d, 0xe0, 0x11, 0xb4, 0x6c, 0x73, 0x50, 0x1b, 0x2e, 0xb4, 0x0a,
+	0xd7, 0x1a, 0x7e, 0x01, 0x4d, 0xd3, 0x32, 0x24, 0xc4, 0x74, 0xed, 0xde, 0xc5, 0xec, 0xf7, 0xa0,
+	0x9d, 0xf7, 0x0a, 0xc9, 0x1e, 0x1a, 0x2e, 0xb6, 0x0e, 0xd7, 0x9a, 0x1e, 0xc3, 0xd6, 0xab, 0x40,
+	0xc8, 0x52, 0x7b, 0x70, 0xed, 0x52, 0x7d, 0xb8, 0xa6, 0x8f, 0x48, 0xbe, 0x80, 0x96, 0x6d, 0xd2,
+	0xe9, 0xe9, 0x2e, 0xf4, 0x06, 0xfb, 0x7b, 0xab, 0xfa, 0x78, 0xe4, 0x25, 0xf4, 0xe6, 0x5b, 0x24,
+	0xe4, 0xb6, 0x0e, 0x7d, 0x45, 0xa3, 0xa8, 0xdf, 0x5f, 0x25, 0x32, 0x8e, 0xfe, 0x00, 0x76, 0x96,
+	0xda, 0x2d, 0x6b, 0x67, 0xf1, 0x43, 0xb5, 0xe1, 0xd6, 0x76, 0x67, 0xbe, 0x82, 0xcd, 0x17, 0x61,
+	0x26, 0x2e, 0x4f, 0x59, 0x94, 0xc4, 0x71, 0xb8, 0xd6, 0xcd, 0xba, 0x7c, 0x7e, 0x0a, 0xf5, 0x63,
+	0xfd, 0x4f, 0x03, 0xa5, 0x97, 0x98, 0x9e, 0xc2, 0xce, 0x52, 0xd3, 0x82, 0xfc, 0x2a, 0xef, 0xdf,
+	0xa8, 0xc7, 0xda, 0x0a, 0xa3, 0xfd, 0x75, 0x0f, 0x3a, 0xf2, 0x63, 0xa8, 0x9e, 0xcf, 0x48, 0xd7,
+	0x3e, 0x71, 0xb4, 0x7a, 0xcf, 0x92, 0x46, 0xe9, 0x97, 0xd0, 0xce, 0x9f, 0x2f, 0x7a, 0x67, 0x2c,
+	0x3e, 0x7d, 0xfa, 0xb7, 0x16, 0xb8, 0xc5, 0xc1, 0x7f, 0x59, 0xd4, 0x8b, 0x6b, 0xf3, 0xb0, 0xb7,
+	0xaa, 0x94, 0x45, 0xe3, 0xe1, 0x9c, 0x31, 0x9e, 0x81, 0xa5, 0xea, 0x73, 0x8d, 0xf1, 0xe7, 0xd0,
+	0x34, 0xb5, 0xa2, 0x3e, 0x04, 0xf3, 0x85, 0x67, 0x7f, 0x77, 0x8e, 0x97, 0x1f, 0xd6, 0x5f, 0x41,
+	0x3b, 0xaf, 0xbf, 0xd6, 0x46, 0x7b, 0x6b, 0x65, 0x99, 0x46, 0x3e, 0x83, 0x86, 0xbe, 0x29, 0xc9,
+	0x4e, 0xf9, 0xd6, 0x2c, 0x41, 0xdb, 0xc2, 0x35, 0xfc, 0x15, 0x74, 0x4a, 0x97, 0xd7, 0xdb, 0x4f,
+	0xcc, 0xaa, 0x1b, 0xf1, 0x31, 0x40, 0x81, 0xf7, 0xe4, 0x96, 0x51, 0x9b, 0xc7, 0xf4, 0xb5, 0x5b,
+	0xec, 0x31, 0x74, 0x5d, 0x16, 0xc5, 0x53, 0x66, 0xed, 0x49, 0xe9, 0x67, 0xbe, 0xcf, 0xf8, 0x4b,
+	0xd8, 0x3a, 0xa5, 0xe9, 0x95, 0xd1, 0x7e, 0x19, 0xc7, 0xfe, 0x7b, 0x99, 0x3f, 0x81, 0x5e, 0xc9,
+	0xfc, 0x98, 0xbe, 0x9f, 0xf5, 0x11, 0x6c, 0x0d, 0xe9, 0x94, 0x95, 0x32, 0xf2, 0xbe, 0xe7, 0x6b,
+	0xdc, 0x50, 0xf4, 0xc3, 0xff, 0x1d, 0x00, 0xc0, 0xcc, 0xe9, 0xc5, 0x1a, 0x25, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ManagerServiceClient is the client API for ManagerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ManagerServiceClient interface {
+	Status(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*StatusResponse, error)
+	WatchStatus(ctx context.Context, in *WatchStatusRequest, opts ...grpc.CallOption) (ManagerService_WatchStatusClient, error)
+	NetInfo(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*NetInfoResponse, error)
+	PruneBlocks(ctx context.Context, in *PruneBlocksRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	PruneBlocksStream(ctx context.Context, in *PruneBlocksRequest, opts ...grpc.CallOption) (ManagerService_PruneBlocksStreamClient, error)
+	DealPeer(ctx context.Context, in *DealPeerRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	StopPeer(ctx context.Context, in *StopPeerRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	BanPeer(ctx context.Context, in *BanPeerRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	UnbanPeer(ctx context.Context, in *UnbanPeerRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	ListBannedPeers(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*BannedPeersResponse, error)
+	AuditLog(ctx context.Context, in *AuditLogRequest, opts ...grpc.CallOption) (*AuditLogResponse, error)
+	UnconfirmedTxs(ctx context.Context, in *UnconfirmedTxsRequest, opts ...grpc.CallOption) (*UnconfirmedTxsResponse, error)
+	NumUnconfirmedTxs(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*NumUnconfirmedTxsResponse, error)
+	FlushMempool(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error)
+	Block(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error)
+	BlockResults(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResultsResponse, error)
+	Tx(ctx context.Context, in *TxRequest, opts ...grpc.CallOption) (*TxResponse, error)
+	Validator(ctx context.Context, in *ValidatorRequest, opts ...grpc.CallOption) (*ValidatorResponse, error)
+	GetLogLevel(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*LogLevelResponse, error)
+	SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*LogLevelResponse, error)
+	Profile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (ManagerService_ProfileClient, error)
+	Resources(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ResourcesResponse, error)
+	Health(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthResponse, error)
+	AddressBook(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*AddressBookResponse, error)
+	AddAddress(ctx context.Context, in *AddAddressRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	RemoveAddress(ctx context.Context, in *AddressRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	MarkAddressGood(ctx context.Context, in *AddressRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	MarkAddressBad(ctx context.Context, in *AddressRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	SaveAddressBook(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error)
+}
+
+type managerServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewManagerServiceClient(cc *grpc.ClientConn) ManagerServiceClient {
+	return &managerServiceClient{cc}
+}
+
+func (c *managerServiceClient) Status(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*StatusResponse, error) {
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, "/pb.ManagerService/Status", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) WatchStatus(ctx context.Context, in *WatchStatusRequest, opts ...grpc.CallOption) (ManagerService_WatchStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ManagerService_serviceDesc.Streams[0], "/pb.ManagerService/WatchStatus", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &managerServiceWatchStatusClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ManagerService_WatchStatusClient interface {
+	Recv() (*StatusResponse, error)
+	grpc.ClientStream
+}
+
+type managerServiceWatchStatusClient struct {
+	grpc.ClientStream
+}
+
+func (x *managerServiceWatchStatusClient) Recv() (*StatusResponse, error) {
+	m := new(StatusResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *managerServiceClient) NetInfo(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*NetInfoResponse, error) {
+	out := new(NetInfoResponse)
+	err := c.cc.Invoke(ctx, "/pb.ManagerService/NetInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) PruneBlocks(ctx context.Context, in *PruneBlocksRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/pb.ManagerService/PruneBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) PruneBlocksStream(ctx context.Context, in *PruneBlocksRequest, opts ...grpc.CallOption) (ManagerService_PruneBlocksStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ManagerService_serviceDesc.Streams[1], "/pb.ManagerService/PruneBlocksStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &managerServicePruneBlocksStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ManagerService_PruneBlocksStreamClient interface {
+	Recv() (*PruneBlocksResponse, error)
+	grpc.ClientStream
+}
+
+type managerServicePruneBlocksStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *managerServicePruneBlocksStreamClient) Recv() (*PruneBlocksResponse, error) {
+	m := new(PruneBlocksResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *managerServiceClient) DealPeer(ctx context.Context, in *DealPeerRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/pb.ManagerService/DealPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) StopPeer(ctx context.Context, in *StopPeerRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/pb.ManagerService/StopPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) BanPeer(ctx context.Context, in *BanPeerRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/pb.ManagerService/BanPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) UnbanPeer(ctx context.Context, in *UnbanPeerRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/pb.ManagerService/UnbanPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) ListBannedPeers(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*BannedPeersResponse, error) {
+	out := new(BannedPeersResponse)
+	err := c.cc.Invoke(ctx, "/pb.ManagerService/ListBannedPeers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) AuditLog(ctx context.Context, in *AuditLogRequest, opts ...grpc.CallOption) (*AuditLogResponse, error) {
+	out := new(AuditLogResponse)
+	err := c.cc.Invoke(ctx, "/pb.ManagerService/AuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) UnconfirmedTxs(ctx context.Context, in *UnconfirmedTxsRequest, opts ...grpc.CallOption) (*UnconfirmedTxsResponse, error) {
+	out := new(UnconfirmedTxsResponse)
+	err := c.cc.Invoke(ctx, "/pb.ManagerService/UnconfirmedTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) NumUnconfirmedTxs(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*NumUnconfirmedTxsResponse, error) {
+	out := new(NumUnconfirmedTxsResponse)
+	err := c.cc.Invoke(ctx, "/pb.ManagerService/NumUnconfirmedTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) FlushMempool(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/pb.ManagerService/FlushMempool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) Block(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
+	out := new(BlockResponse)
+	err := c.cc.Invoke(ctx, "/pb.ManagerService/Block", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) BlockResults(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResultsResponse, error) {
+	out := new(BlockResultsResponse)
+	err := c.cc.Invoke(ctx, "/pb.ManagerService/BlockResults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) Tx(ctx context.Context, in *TxRequest, opts ...grpc.CallOption) (*TxResponse, error) {
+	out := new(TxResponse)
+	err := c.cc.Invoke(ctx, "/pb.ManagerService/Tx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) Validator(ctx context.Context, in *ValidatorRequest, opts ...grpc.CallOption) (*ValidatorResponse, error) {
+	out := new(ValidatorResponse)
+	err := c.cc.Invoke(ctx, "/pb.ManagerService/Validator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) GetLogLevel(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*LogLevelResponse, error) {
+	out := new(LogLevelResponse)
+	err := c.cc.Invoke(ctx, "/pb.ManagerService/GetLogLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*LogLevelResponse, error) {
+	out := new(LogLevelResponse)
+	err := c.cc.Invoke(ctx, "/pb.ManagerService/SetLogLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) Profile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (ManagerService_ProfileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ManagerService_serviceDesc.Streams[2], "/pb.ManagerService/Profile", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &managerServiceProfileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ManagerService_ProfileClient interface {
+	Recv() (*ProfileResponse, error)
+	grpc.ClientStream
+}
+
+type managerServiceProfileClient struct {
+	grpc.ClientStream
+}
+
+func (x *managerServiceProfileClient) Recv() (*ProfileResponse, error) {
+	m := new(ProfileResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *managerServiceClient) Resources(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ResourcesResponse, error) {
+	out := new(ResourcesResponse)
+	err := c.cc.Invoke(ctx, "/pb.ManagerService/Resources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) Health(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthResponse, error) {
+	out := new(HealthResponse)
+	err := c.cc.Invoke(ctx, "/pb.ManagerService/Health", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) AddressBook(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*AddressBookResponse, error) {
+	out := new(AddressBookResponse)
+	err := c.cc.Invoke(ctx, "/pb.ManagerService/AddressBook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) AddAddress(ctx context.Context, in *AddAddressRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/pb.ManagerService/AddAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) RemoveAddress(ctx context.Context, in *AddressRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/pb.ManagerService/RemoveAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) MarkAddressGood(ctx context.Context, in *AddressRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/pb.ManagerService/MarkAddressGood", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) MarkAddressBad(ctx context.Context, in *AddressRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/pb.ManagerService/MarkAddressBad", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) SaveAddressBook(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/pb.ManagerService/SaveAddressBook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ManagerServiceServer is the server API for ManagerService service.
+type ManagerServiceServer interface {
+	Status(context.Context, *empty.Empty) (*StatusResponse, error)
+	WatchStatus(*WatchStatusRequest, ManagerService_WatchStatusServer) error
+	NetInfo(context.Context, *empty.Empty) (*NetInfoResponse, error)
+	PruneBlocks(context.Context, *PruneBlocksRequest) (*empty.Empty, error)
+	PruneBlocksStream(*PruneBlocksRequest, ManagerService_PruneBlocksStreamServer) error
+	DealPeer(context.Context, *DealPeerRequest) (*empty.Empty, error)
+	StopPeer(context.Context, *StopPeerRequest) (*empty.Empty, error)
+	BanPeer(context.Context, *BanPeerRequest) (*empty.Empty, error)
+	UnbanPeer(context.Context, *UnbanPeerRequest) (*empty.Empty, error)
+	ListBannedPeers(context.Context, *empty.Empty) (*BannedPeersResponse, error)
+	AuditLog(context.Context, *AuditLogRequest) (*AuditLogResponse, error)
+	UnconfirmedTxs(context.Context, *UnconfirmedTxsRequest) (*UnconfirmedTxsResponse, error)
+	NumUnconfirmedTxs(context.Context, *empty.Empty) (*NumUnconfirmedTxsResponse, error)
+	FlushMempool(context.Context, *empty.Empty) (*empty.Empty, error)
+	Block(context.Context, *BlockRequest) (*BlockResponse, error)
+	BlockResults(context.Context, *BlockRequest) (*BlockResultsResponse, error)
+	Tx(context.Context, *TxRequest) (*TxResponse, error)
+	Validator(context.Context, *ValidatorRequest) (*ValidatorResponse, error)
+	GetLogLevel(context.Context, *empty.Empty) (*LogLevelResponse, error)
+	SetLogLevel(context.Context, *SetLogLevelRequest) (*LogLevelResponse, error)
+	Profile(*ProfileRequest, ManagerService_ProfileServer) error
+	Resources(context.Context, *empty.Empty) (*ResourcesResponse, error)
+	Health(context.Context, *HealthRequest) (*HealthResponse, error)
+	AddressBook(context.Context, *empty.Empty) (*AddressBookResponse, error)
+	AddAddress(context.Context, *AddAddressRequest) (*empty.Empty, error)
+	RemoveAddress(context.Context, *AddressRequest) (*empty.Empty, error)
+	MarkAddressGood(context.Context, *AddressRequest) (*empty.Empty, error)
+	MarkAddressBad(context.Context, *AddressRequest) (*empty.Empty, error)
+	SaveAddressBook(context.Context, *empty.Empty) (*empty.Empty, error)
+}
+
+// UnimplementedManagerServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedManagerServiceServer struct {
+}
+
+func (*UnimplementedManagerServiceServer) Status(ctx context.Context, req *empty.Empty) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
+}
+func (*UnimplementedManagerServiceServer) WatchStatus(req *WatchStatusRequest, srv ManagerService_WatchStatusServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchStatus not implemented")
+}
+func (*UnimplementedManagerServiceServer) NetInfo(ctx context.Context, req *empty.Empty) (*NetInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NetInfo not implemented")
+}
+func (*UnimplementedManagerServiceServer) PruneBlocks(ctx context.Context, req *PruneBlocksRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneBlocks not implemented")
+}
+func (*UnimplementedManagerServiceServer) PruneBlocksStream(req *PruneBlocksRequest, srv ManagerService_PruneBlocksStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method PruneBlocksStream not implemented")
+}
+func (*UnimplementedManagerServiceServer) DealPeer(ctx context.Context, req *DealPeerRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DealPeer not implemented")
+}
+func (*UnimplementedManagerServiceServer) StopPeer(ctx context.Context, req *StopPeerRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopPeer not implemented")
+}
+func (*UnimplementedManagerServiceServer) BanPeer(ctx context.Context, req *BanPeerRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BanPeer not implemented")
+}
+func (*UnimplementedManagerServiceServer) UnbanPeer(ctx context.Context, req *UnbanPeerRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbanPeer not implemented")
+}
+func (*UnimplementedManagerServiceServer) ListBannedPeers(ctx context.Context, req *empty.Empty) (*BannedPeersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBannedPeers not implemented")
+}
+func (*UnimplementedManagerServiceServer) AuditLog(ctx context.Context, req *AuditLogRequest) (*AuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditLog not implemented")
+}
+func (*UnimplementedManagerServiceServer) UnconfirmedTxs(ctx context.Context, req *UnconfirmedTxsRequest) (*UnconfirmedTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnconfirmedTxs not implemented")
+}
+func (*UnimplementedManagerServiceServer) NumUnconfirmedTxs(ctx context.Context, req *empty.Empty) (*NumUnconfirmedTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NumUnconfirmedTxs not implemented")
+}
+func (*UnimplementedManagerServiceServer) FlushMempool(ctx context.Context, req *empty.Empty) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlushMempool not implemented")
+}
+func (*UnimplementedManagerServiceServer) Block(ctx context.Context, req *BlockRequest) (*BlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Block not implemented")
+}
+func (*UnimplementedManagerServiceServer) BlockResults(ctx context.Context, req *BlockRequest) (*BlockResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockResults not implemented")
+}
+func (*UnimplementedManagerServiceServer) Tx(ctx context.Context, req *TxRequest) (*TxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tx not implemented")
+}
+func (*UnimplementedManagerServiceServer) Validator(ctx context.Context, req *ValidatorRequest) (*ValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validator not implemented")
+}
+func (*UnimplementedManagerServiceServer) GetLogLevel(ctx context.Context, req *empty.Empty) (*LogLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLogLevel not implemented")
+}
+func (*UnimplementedManagerServiceServer) SetLogLevel(ctx context.Context, req *SetLogLevelRequest) (*LogLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+}
+func (*UnimplementedManagerServiceServer) Profile(req *ProfileRequest, srv ManagerService_ProfileServer) error {
+	return status.Errorf(codes.Unimplemented, "method Profile not implemented")
+}
+func (*UnimplementedManagerServiceServer) Resources(ctx context.Context, req *empty.Empty) (*ResourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Resources not implemented")
+}
+func (*UnimplementedManagerServiceServer) Health(ctx context.Context, req *HealthRequest) (*HealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
+}
+func (*UnimplementedManagerServiceServer) AddressBook(ctx context.Context, req *empty.Empty) (*AddressBookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressBook not implemented")
+}
+func (*UnimplementedManagerServiceServer) AddAddress(ctx context.Context, req *AddAddressRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAddress not implemented")
+}
+func (*UnimplementedManagerServiceServer) RemoveAddress(ctx context.Context, req *AddressRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAddress not implemented")
+}
+func (*UnimplementedManagerServiceServer) MarkAddressGood(ctx context.Context, req *AddressRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkAddressGood not implemented")
+}
+func (*UnimplementedManagerServiceServer) MarkAddressBad(ctx context.Context, req *AddressRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkAddressBad not implemented")
+}
+func (*UnimplementedManagerServiceServer) SaveAddressBook(ctx context.Context, req *empty.Empty) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveAddressBook not implemented")
+}
+
+func RegisterManagerServiceServer(s *grpc.Server, srv ManagerServiceServer) {
+	s.RegisterService(&_ManagerService_serviceDesc, srv)
+}
+
+func _ManagerService_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ManagerService/Status",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).Status(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_WatchStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchStatusRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ManagerServiceServer).WatchStatus(m, &managerServiceWatchStatusServer{stream})
+}
+
+type ManagerService_WatchStatusServer interface {
+	Send(*StatusResponse) error
+	grpc.ServerStream
+}
+
+type managerServiceWatchStatusServer struct {
+	grpc.ServerStream
+}
+
+func (x *managerServiceWatchStatusServer) Send(m *StatusResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ManagerService_NetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).NetInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ManagerService/NetInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).NetInfo(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_PruneBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PruneBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).PruneBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ManagerService/PruneBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).PruneBlocks(ctx, req.(*PruneBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_PruneBlocksStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PruneBlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ManagerServiceServer).PruneBlocksStream(m, &managerServicePruneBlocksStreamServer{stream})
+}
+
+type ManagerService_PruneBlocksStreamServer interface {
+	Send(*PruneBlocksResponse) error
+	grpc.ServerStream
+}
+
+type managerServicePruneBlocksStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *managerServicePruneBlocksStreamServer) Send(m *PruneBlocksResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ManagerService_DealPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DealPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).DealPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ManagerService/DealPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).DealPeer(ctx, req.(*DealPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_StopPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).StopPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ManagerService/StopPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).StopPeer(ctx, req.(*StopPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_BanPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).BanPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ManagerService/BanPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).BanPeer(ctx, req.(*BanPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_UnbanPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbanPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).UnbanPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ManagerService/UnbanPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).UnbanPeer(ctx, req.(*UnbanPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_ListBannedPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).ListBannedPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ManagerService/ListBannedPeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).ListBannedPeers(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_AuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).AuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ManagerService/AuditLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).AuditLog(ctx, req.(*AuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_UnconfirmedTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnconfirmedTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).UnconfirmedTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ManagerService/UnconfirmedTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).UnconfirmedTxs(ctx, req.(*UnconfirmedTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_NumUnconfirmedTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).NumUnconfirmedTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ManagerService/NumUnconfirmedTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).NumUnconfirmedTxs(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_FlushMempool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).FlushMempool(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ManagerService/DealPeer",
+		FullMethod: "/pb.ManagerService/FlushMempool",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ManagerServiceServer).DealPeer(ctx, req.(*DealPeerRequest))
+		return srv.(ManagerServiceServer).FlushMempool(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_Block_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).Block(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ManagerService/Block",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).Block(ctx, req.(*BlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_BlockResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).BlockResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ManagerService/BlockResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).BlockResults(ctx, req.(*BlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_Tx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).Tx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ManagerService/Tx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).Tx(ctx, req.(*TxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_Validator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).Validator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ManagerService/Validator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).Validator(ctx, req.(*ValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_GetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).GetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ManagerService/GetLogLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).GetLogLevel(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ManagerService/SetLogLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).SetLogLevel(ctx, req.(*SetLogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_Profile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ProfileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ManagerServiceServer).Profile(m, &managerServiceProfileServer{stream})
+}
+
+type ManagerService_ProfileServer interface {
+	Send(*ProfileResponse) error
+	grpc.ServerStream
+}
+
+type managerServiceProfileServer struct {
+	grpc.ServerStream
+}
+
+func (x *managerServiceProfileServer) Send(m *ProfileResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ManagerService_Resources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).Resources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ManagerService/Resources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).Resources(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).Health(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ManagerService/Health",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).Health(ctx, req.(*HealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_AddressBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).AddressBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ManagerService/AddressBook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).AddressBook(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_AddAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).AddAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ManagerService/AddAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).AddAddress(ctx, req.(*AddAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_RemoveAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).RemoveAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ManagerService/RemoveAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).RemoveAddress(ctx, req.(*AddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_MarkAddressGood_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).MarkAddressGood(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ManagerService/MarkAddressGood",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).MarkAddressGood(ctx, req.(*AddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_MarkAddressBad_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).MarkAddressBad(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ManagerService/MarkAddressBad",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).MarkAddressBad(ctx, req.(*AddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_SaveAddressBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).SaveAddressBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ManagerService/SaveAddressBook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).SaveAddressBook(ctx, req.(*empty.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1325,7 +4803,111 @@ var _ManagerService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DealPeer",
 			Handler:    _ManagerService_DealPeer_Handler,
 		},
+		{
+			MethodName: "StopPeer",
+			Handler:    _ManagerService_StopPeer_Handler,
+		},
+		{
+			MethodName: "BanPeer",
+			Handler:    _ManagerService_BanPeer_Handler,
+		},
+		{
+			MethodName: "UnbanPeer",
+			Handler:    _ManagerService_UnbanPeer_Handler,
+		},
+		{
+			MethodName: "ListBannedPeers",
+			Handler:    _ManagerService_ListBannedPeers_Handler,
+		},
+		{
+			MethodName: "AuditLog",
+			Handler:    _ManagerService_AuditLog_Handler,
+		},
+		{
+			MethodName: "UnconfirmedTxs",
+			Handler:    _ManagerService_UnconfirmedTxs_Handler,
+		},
+		{
+			MethodName: "NumUnconfirmedTxs",
+			Handler:    _ManagerService_NumUnconfirmedTxs_Handler,
+		},
+		{
+			MethodName: "FlushMempool",
+			Handler:    _ManagerService_FlushMempool_Handler,
+		},
+		{
+			MethodName: "Block",
+			Handler:    _ManagerService_Block_Handler,
+		},
+		{
+			MethodName: "BlockResults",
+			Handler:    _ManagerService_BlockResults_Handler,
+		},
+		{
+			MethodName: "Tx",
+			Handler:    _ManagerService_Tx_Handler,
+		},
+		{
+			MethodName: "Validator",
+			Handler:    _ManagerService_Validator_Handler,
+		},
+		{
+			MethodName: "GetLogLevel",
+			Handler:    _ManagerService_GetLogLevel_Handler,
+		},
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _ManagerService_SetLogLevel_Handler,
+		},
+		{
+			MethodName: "Resources",
+			Handler:    _ManagerService_Resources_Handler,
+		},
+		{
+			MethodName: "Health",
+			Handler:    _ManagerService_Health_Handler,
+		},
+		{
+			MethodName: "AddressBook",
+			Handler:    _ManagerService_AddressBook_Handler,
+		},
+		{
+			MethodName: "AddAddress",
+			Handler:    _ManagerService_AddAddress_Handler,
+		},
+		{
+			MethodName: "RemoveAddress",
+			Handler:    _ManagerService_RemoveAddress_Handler,
+		},
+		{
+			MethodName: "MarkAddressGood",
+			Handler:    _ManagerService_MarkAddressGood_Handler,
+		},
+		{
+			MethodName: "MarkAddressBad",
+			Handler:    _ManagerService_MarkAddressBad_Handler,
+		},
+		{
+			MethodName: "SaveAddressBook",
+			Handler:    _ManagerService_SaveAddressBook_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchStatus",
+			Handler:       _ManagerService_WatchStatus_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "PruneBlocksStream",
+			Handler:       _ManagerService_PruneBlocksStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Profile",
+			Handler:       _ManagerService_Profile_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "manager.proto",
 }
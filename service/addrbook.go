@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/MinterTeam/minter-node-cli/pb"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/tendermint/tendermint/p2p"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io/ioutil"
+	"os"
+	"sort"
+	"time"
+)
+
+// the bucket types of the address book, new addresses are moved to the old buckets once marked good
+var addressBucketNames = map[byte]string{
+	0x01: "new",
+	0x02: "old",
+}
+
+// addressBookFile is the part of the saved address book listed by the manager
+type addressBookFile struct {
+	Addrs []struct {
+		Addr        *p2p.NetAddress `json:"addr"`
+		Src         *p2p.NetAddress `json:"src"`
+		Attempts    int32           `json:"attempts"`
+		LastAttempt time.Time       `json:"last_attempt"`
+		LastSuccess time.Time       `json:"last_success"`
+		BucketType  byte            `json:"bucket_type"`
+	} `json:"addrs"`
+}
+
+func (m *Manager) addressBook() (AddressBook, error) {
+	if node, ok := m.tmNode.(AddressBookNode); ok {
+		if book := node.AddressBook(); book != nil {
+			return book, nil
+		}
+	}
+	return nil, status.Error(codes.FailedPrecondition, "the node does not expose its address book")
+}
+
+// addressOf returns the address book entry of the peer
+func (m *Manager) addressOf(req *pb.AddressRequest) (AddressBook, *p2p.NetAddress, error) {
+	book, err := m.addressBook()
+	if err != nil {
+		return nil, nil, err
+	}
+	id, err := parsePeerID(req.Id)
+	if err != nil {
+		return nil, nil, err
+	}
+	addr := &p2p.NetAddress{ID: id}
+	if !book.HasAddress(addr) {
+		return nil, nil, status.Errorf(codes.NotFound, "peer %s is not in the address book", id)
+	}
+	return book, addr, nil
+}
+
+// AddressBook lists the entries of the address book as last saved by the node, the old bucket first.
+// The node saves the book periodically and on SaveAddressBook, so the list may be stale: SavedAt tells how much.
+func (m *Manager) AddressBook(context.Context, *empty.Empty) (*pb.AddressBookResponse, error) {
+	if _, err := m.addressBook(); err != nil {
+		return new(pb.AddressBookResponse), err
+	}
+
+	file := m.cfg.P2P.AddrBookFile()
+	response := &pb.AddressBookResponse{}
+	info, err := os.Stat(file)
+	if os.IsNotExist(err) {
+		return response, nil
+	}
+	if err != nil {
+		return response, status.Error(codes.Internal, err.Error())
+	}
+	response.SavedAt = info.ModTime().UTC().Format(time.RFC3339)
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return response, status.Error(codes.Internal, err.Error())
+	}
+	var saved addressBookFile
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return response, status.Errorf(codes.Internal, "address book %s: %s", file, err)
+	}
+
+	for _, entry := range saved.Addrs {
+		if entry.Addr == nil {
+			continue
+		}
+		address := &pb.AddressBookResponse_Address{
+			Id:          string(entry.Addr.ID),
+			Address:     entry.Addr.DialString(),
+			Bucket:      addressBucketNames[entry.BucketType],
+			Attempts:    entry.Attempts,
+			LastAttempt: formatAddressTime(entry.LastAttempt),
+			LastSuccess: formatAddressTime(entry.LastSuccess),
+		}
+		if entry.Src != nil {
+			address.Source = entry.Src.String()
+		}
+		response.Addresses = append(response.Addresses, address)
+	}
+	sort.Slice(response.Addresses, func(i, j int) bool {
+		a, b := response.Addresses[i], response.Addresses[j]
+		if a.Bucket != b.Bucket {
+			return a.Bucket == "old"
+		}
+		return a.Id < b.Id
+	})
+	return response, nil
+}
+
+func formatAddressTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// AddAddress adds the id@ip:port address to the new buckets of the book, as its own source
+func (m *Manager) AddAddress(ctx context.Context, req *pb.AddAddressRequest) (*empty.Empty, error) {
+	res := new(empty.Empty)
+	book, err := m.addressBook()
+	if err != nil {
+		return res, err
+	}
+	addr, err := p2p.NewNetAddressString(req.Address)
+	if err != nil {
+		return res, status.Errorf(codes.InvalidArgument, "invalid address %q: %s", req.Address, err)
+	}
+	if m.bans.isBanned(addr.ID) {
+		return res, status.Errorf(codes.FailedPrecondition, "peer %s is banned", addr.ID)
+	}
+	if err := book.AddAddress(addr, addr); err != nil {
+		return res, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return res, nil
+}
+
+func (m *Manager) RemoveAddress(ctx context.Context, req *pb.AddressRequest) (*empty.Empty, error) {
+	res := new(empty.Empty)
+	book, addr, err := m.addressOf(req)
+	if err != nil {
+		return res, err
+	}
+	book.RemoveAddress(addr)
+	return res, nil
+}
+
+// MarkAddressGood moves the address to the old buckets, which the node prefers when dialing
+func (m *Manager) MarkAddressGood(ctx context.Context, req *pb.AddressRequest) (*empty.Empty, error) {
+	res := new(empty.Empty)
+	book, addr, err := m.addressOf(req)
+	if err != nil {
+		return res, err
+	}
+	book.MarkGood(addr.ID)
+	return res, nil
+}
+
+// MarkAddressBad reports the address as bad, which Tendermint currently handles by removing it from the book
+func (m *Manager) MarkAddressBad(ctx context.Context, req *pb.AddressRequest) (*empty.Empty, error) {
+	res := new(empty.Empty)
+	book, addr, err := m.addressOf(req)
+	if err != nil {
+		return res, err
+	}
+	book.MarkBad(addr)
+	return res, nil
+}
+
+// SaveAddressBook writes the address book to its file now instead of at the next periodic save
+func (m *Manager) SaveAddressBook(context.Context, *empty.Empty) (*empty.Empty, error) {
+	res := new(empty.Empty)
+	book, err := m.addressBook()
+	if err != nil {
+		return res, err
+	}
+	book.Save()
+	return res, nil
+}
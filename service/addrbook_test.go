@@ -0,0 +1,25 @@
+package service
+
+import (
+	"context"
+	"github.com/MinterTeam/minter-go-node/config"
+	"github.com/golang/protobuf/ptypes/empty"
+	tmNode "github.com/tendermint/tendermint/node"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+// TestNewNodeAddressBook checks that the address book methods are refused with the Tendermint node,
+// whose address book is private
+func TestNewNodeAddressBook(t *testing.T) {
+	node := NewNode(&tmNode.Node{})
+	if _, ok := node.(AddressBookNode); ok {
+		t.Error("the Tendermint node has an address book")
+	}
+
+	m := NewManagerWith(newFakeBlockchain(testHeight), newFakeTendermintRPC(), node, config.DefaultConfig())
+	if _, err := m.SaveAddressBook(context.Background(), &empty.Empty{}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("SaveAddressBook() error = %v, want %s", err, codes.FailedPrecondition)
+	}
+}
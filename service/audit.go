@@ -0,0 +1,290 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/MinterTeam/minter-node-cli/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"os"
+	"path"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// mutatingMethods are the manager methods recorded in the audit log
+var mutatingMethods = map[string]bool{
+	"/pb.ManagerService/DealPeer":          true,
+	"/pb.ManagerService/StopPeer":          true,
+	"/pb.ManagerService/BanPeer":           true,
+	"/pb.ManagerService/UnbanPeer":         true,
+	"/pb.ManagerService/PruneBlocks":       true,
+	"/pb.ManagerService/PruneBlocksStream": true,
+	"/pb.ManagerService/FlushMempool":      true,
+	"/pb.ManagerService/SetLogLevel":       true,
+	"/pb.ManagerService/AddAddress":        true,
+	"/pb.ManagerService/RemoveAddress":     true,
+	"/pb.ManagerService/MarkAddressGood":   true,
+	"/pb.ManagerService/MarkAddressBad":    true,
+	"/pb.ManagerService/SaveAddressBook":   true,
+}
+
+type auditEntry struct {
+	Time     string          `json:"time"`
+	Caller   string          `json:"caller"`
+	Method   string          `json:"method"`
+	Request  json.RawMessage `json:"request"`
+	Code     string          `json:"code"`
+	Latency  int64           `json:"latency"`
+	PrevHash string          `json:"prev_hash,omitempty"`
+	Hash     string          `json:"hash,omitempty"`
+}
+
+// hash returns the hex sha256 of the entry encoded without its own hash
+func (e auditEntry) hash() (string, error) {
+	e.Hash = ""
+	data, err := json.Marshal(e)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// AuditLog appends a JSON line for every mutating manager call.
+// When chained, every entry carries the hash of the previous one, so that edits are detectable.
+//
+// Entries are written once the call is done. A call whose entry cannot be written still returns its result,
+// as the change is made already and failing it would make the client retry it: the failure is only counted,
+// see Failures.
+type AuditLog struct {
+	mu       sync.Mutex
+	path     string
+	file     *os.File
+	chained  bool
+	lastHash string
+	failures uint64
+}
+
+// OpenAuditLog opens or creates the audit log at the path
+func OpenAuditLog(path string, chained bool) (*AuditLog, error) {
+	audit := &AuditLog{path: path, chained: chained}
+	if chained {
+		err := audit.scan(func(entry auditEntry) {
+			audit.lastHash = entry.Hash
+		})
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	audit.file = file
+
+	return audit, nil
+}
+
+func (a *AuditLog) Close() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.file.Close()
+}
+
+func (a *AuditLog) record(ctx context.Context, method string, req interface{}, err error, latency time.Duration) error {
+	request, marshalErr := json.Marshal(req)
+	if marshalErr != nil {
+		request = []byte("null")
+	}
+	entry := auditEntry{
+		Time:    time.Now().UTC().Format(time.RFC3339Nano),
+		Caller:  callerIdentity(ctx),
+		Method:  method,
+		Request: request,
+		Code:    status.Code(err).String(),
+		Latency: int64(latency),
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.chained {
+		entry.PrevHash = a.lastHash
+		hash, err := entry.hash()
+		if err != nil {
+			return err
+		}
+		entry.Hash = hash
+	}
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if _, err := a.file.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	if err := a.file.Sync(); err != nil {
+		return err
+	}
+	a.lastHash = entry.Hash
+
+	return nil
+}
+
+// Failures is the number of calls whose entry could not be written
+func (a *AuditLog) Failures() uint64 {
+	return atomic.LoadUint64(&a.failures)
+}
+
+func (a *AuditLog) scan(fn func(entry auditEntry)) error {
+	file, err := os.Open(a.path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return scanAuditEntries(file, func(_ int, entry auditEntry) error {
+		fn(entry)
+		return nil
+	})
+}
+
+func scanAuditEntries(r io.Reader, fn func(line int, entry auditEntry) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var entry auditEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return fmt.Errorf("line %d: %s", line, err)
+		}
+		if err := fn(line, entry); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// VerifyAuditLog checks the hash chain of the audit log and reports the first broken entry
+func VerifyAuditLog(r io.Reader) error {
+	lastHash := ""
+	return scanAuditEntries(r, func(line int, entry auditEntry) error {
+		if entry.PrevHash != lastHash {
+			return fmt.Errorf("line %d: previous hash mismatch", line)
+		}
+		hash, err := entry.hash()
+		if err != nil {
+			return fmt.Errorf("line %d: %s", line, err)
+		}
+		if hash != entry.Hash {
+			return fmt.Errorf("line %d: hash mismatch", line)
+		}
+		lastHash = entry.Hash
+		return nil
+	})
+}
+
+// Entries returns the entries recorded since the time, optionally only for the method,
+// given either by its full name or by its name within the service
+func (a *AuditLog) Entries(since time.Time, method string) ([]*pb.AuditLogResponse_Entry, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	var entries []*pb.AuditLogResponse_Entry
+	err := a.scan(func(entry auditEntry) {
+		if method != "" && entry.Method != method && path.Base(entry.Method) != method {
+			return
+		}
+		if t, err := time.Parse(time.RFC3339Nano, entry.Time); err == nil && t.Before(since) {
+			return
+		}
+		entries = append(entries, &pb.AuditLogResponse_Entry{
+			Time:     entry.Time,
+			Caller:   entry.Caller,
+			Method:   entry.Method,
+			Request:  string(entry.Request),
+			Code:     entry.Code,
+			Latency:  entry.Latency,
+			PrevHash: entry.PrevHash,
+			Hash:     entry.Hash,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (a *AuditLog) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !mutatingMethods[info.FullMethod] {
+		return handler(ctx, req)
+	}
+	start := time.Now()
+	res, err := handler(ctx, req)
+	if recordErr := a.record(ctx, info.FullMethod, req, err, time.Since(start)); recordErr != nil {
+		atomic.AddUint64(&a.failures, 1)
+	}
+	return res, err
+}
+
+func (a *AuditLog) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if !mutatingMethods[info.FullMethod] {
+		return handler(srv, ss)
+	}
+	stream := &recordingServerStream{ServerStream: ss}
+	start := time.Now()
+	err := handler(srv, stream)
+	if recordErr := a.record(ss.Context(), info.FullMethod, stream.request, err, time.Since(start)); recordErr != nil {
+		atomic.AddUint64(&a.failures, 1)
+	}
+	return err
+}
+
+// recordingServerStream keeps the first message received from the client
+type recordingServerStream struct {
+	grpc.ServerStream
+	request interface{}
+}
+
+func (s *recordingServerStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil && s.request == nil {
+		s.request = m
+	}
+	return err
+}
+
+// auditedManager serves the audit log on top of the manager
+type auditedManager struct {
+	pb.ManagerServiceServer
+	audit *AuditLog
+}
+
+func (m auditedManager) AuditLog(ctx context.Context, req *pb.AuditLogRequest) (*pb.AuditLogResponse, error) {
+	var since time.Time
+	if req.Since != "" {
+		var err error
+		since, err = time.Parse(time.RFC3339, req.Since)
+		if err != nil {
+			return new(pb.AuditLogResponse), status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	entries, err := m.audit.Entries(since, req.Method)
+	if err != nil {
+		return new(pb.AuditLogResponse), status.Error(codes.Internal, err.Error())
+	}
+	return &pb.AuditLogResponse{Entries: entries}, nil
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"github.com/MinterTeam/minter-node-cli/pb"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestAuditLog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	logPath := filepath.Join(dir, "audit.log")
+	audit, err := OpenAuditLog(logPath, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer audit.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	socketPath := filepath.Join(dir, "manager.sock")
+	go func() {
+		if err := StartCLIServer(socketPath, &pb.UnimplementedManagerServiceServer{}, ctx, WithAuditLog(audit)); err != nil {
+			t.Log(err)
+		}
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	cc, err := grpc.Dial("passthrough:///unix:///"+socketPath, grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cc.Close()
+	client := pb.NewManagerServiceClient(cc)
+
+	_, _ = client.Status(context.Background(), &empty.Empty{})
+	_, _ = client.DealPeer(context.Background(), &pb.DealPeerRequest{Address: "id@127.0.0.1:26656"})
+	_, _ = client.PruneBlocks(context.Background(), &pb.PruneBlocksRequest{FromHeight: 1, ToHeight: 2})
+
+	response, err := client.AuditLog(context.Background(), &pb.AuditLogRequest{Method: "DealPeer"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(response.Entries) != 1 {
+		t.Fatalf("AuditLog() returned %d entries, want 1", len(response.Entries))
+	}
+	if entry := response.Entries[0]; entry.Code != "Unimplemented" || entry.Request != `{"address":"id@127.0.0.1:26656","persistent":false}` {
+		t.Errorf("unexpected entry %+v", entry)
+	}
+
+	data, err := ioutil.ReadFile(logPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyAuditLog(bytes.NewReader(data)); err != nil {
+		t.Errorf("VerifyAuditLog() = %v", err)
+	}
+	tampered := bytes.Replace(data, []byte(`"to_height":2`), []byte(`"to_height":3`), 1)
+	if err := VerifyAuditLog(bytes.NewReader(tampered)); err == nil {
+		t.Error("VerifyAuditLog() should detect the edit")
+	}
+}
+
+// auditStream is a server stream without messages
+type auditStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s auditStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestAuditLogFailure(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	audit, err := OpenAuditLog(filepath.Join(dir, "audit.log"), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// entries cannot be written anymore
+	if err := audit.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	info := &grpc.UnaryServerInfo{FullMethod: "/pb.ManagerService/FlushMempool"}
+	res, err := audit.unaryInterceptor(ctx, &empty.Empty{}, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "flushed", nil
+	})
+	if res != "flushed" || err != nil {
+		t.Errorf("unaryInterceptor() = %v, %v, want the result of the call", res, err)
+	}
+	streamInfo := &grpc.StreamServerInfo{FullMethod: "/pb.ManagerService/PruneBlocksStream"}
+	err = audit.streamInterceptor(nil, auditStream{ctx: ctx}, streamInfo, func(srv interface{}, stream grpc.ServerStream) error {
+		return nil
+	})
+	if err != nil {
+		t.Errorf("streamInterceptor() = %v, want the result of the call", err)
+	}
+	if failures := audit.Failures(); failures != 2 {
+		t.Errorf("Failures() = %d, want 2", failures)
+	}
+}
@@ -0,0 +1,334 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/user"
+	"strconv"
+)
+
+// Role is the access level of a manager client, each role includes the previous ones
+type Role int
+
+const (
+	RoleNone Role = iota
+	RoleReadOnly
+	RoleOperator
+	RoleAdmin
+)
+
+var roleNames = map[Role]string{
+	RoleNone:     "none",
+	RoleReadOnly: "read-only",
+	RoleOperator: "operator",
+	RoleAdmin:    "admin",
+}
+
+func (r Role) String() string {
+	if name, ok := roleNames[r]; ok {
+		return name
+	}
+	return fmt.Sprintf("Role(%d)", int(r))
+}
+
+func (r *Role) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return err
+	}
+	for role, roleName := range roleNames {
+		if roleName == name {
+			*r = role
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown role %q", name)
+}
+
+// methodRoles is the role required by each manager method, methods missing here require RoleAdmin
+var methodRoles = map[string]Role{
+	"/grpc.health.v1.Health/Check":         RoleNone,
+	"/grpc.health.v1.Health/Watch":         RoleNone,
+	"/pb.ManagerService/Status":            RoleReadOnly,
+	"/pb.ManagerService/WatchStatus":       RoleReadOnly,
+	"/pb.ManagerService/NetInfo":           RoleReadOnly,
+	"/pb.ManagerService/ListBannedPeers":   RoleReadOnly,
+	"/pb.ManagerService/UnconfirmedTxs":    RoleReadOnly,
+	"/pb.ManagerService/NumUnconfirmedTxs": RoleReadOnly,
+	"/pb.ManagerService/Block":             RoleReadOnly,
+	"/pb.ManagerService/BlockResults":      RoleReadOnly,
+	"/pb.ManagerService/Tx":                RoleReadOnly,
+	"/pb.ManagerService/Validator":         RoleReadOnly,
+	"/pb.ManagerService/GetLogLevel":       RoleReadOnly,
+	"/pb.ManagerService/Resources":         RoleReadOnly,
+	"/pb.ManagerService/Health":            RoleReadOnly,
+	"/pb.ManagerService/AddressBook":       RoleReadOnly,
+	"/pb.ManagerService/DealPeer":          RoleOperator,
+	"/pb.ManagerService/StopPeer":          RoleOperator,
+	"/pb.ManagerService/BanPeer":           RoleOperator,
+	"/pb.ManagerService/UnbanPeer":         RoleOperator,
+	"/pb.ManagerService/AuditLog":          RoleOperator,
+	"/pb.ManagerService/SetLogLevel":       RoleOperator,
+	"/pb.ManagerService/AddAddress":        RoleOperator,
+	"/pb.ManagerService/RemoveAddress":     RoleOperator,
+	"/pb.ManagerService/MarkAddressGood":   RoleOperator,
+	"/pb.ManagerService/MarkAddressBad":    RoleOperator,
+	"/pb.ManagerService/SaveAddressBook":   RoleOperator,
+	"/pb.ManagerService/PruneBlocks":       RoleAdmin,
+	"/pb.ManagerService/PruneBlocksStream": RoleAdmin,
+	"/pb.ManagerService/FlushMempool":      RoleAdmin,
+	"/pb.ManagerService/Profile":           RoleAdmin,
+}
+
+func requiredRole(method string) Role {
+	if role, ok := methodRoles[method]; ok {
+		return role
+	}
+	return RoleAdmin
+}
+
+// Policy maps the uid and gid of processes connecting to the manager socket,
+// and the certificate CN of TLS clients, to roles.
+// The user running the node is always granted RoleAdmin.
+//
+// SO_PEERCRED only carries the primary gid of the process, groups also match the supplementary groups
+// of its user in the group database, whatever groups the process itself runs with.
+type Policy struct {
+	users      map[uint32]Role
+	groups     map[uint32]Role
+	identities map[string]Role
+	def        Role
+	ownerID    int
+	// groupIDs lists the supplementary groups of a user
+	groupIDs func(uid uint32) ([]string, error)
+}
+
+type policyFile struct {
+	Users      map[string]Role `json:"users"`
+	Groups     map[string]Role `json:"groups"`
+	Identities map[string]Role `json:"identities"`
+	Default    Role            `json:"default"`
+}
+
+// LoadPolicy reads a JSON policy file, users and groups are given by name or numeric id,
+// identities are client certificate CNs:
+//
+//	{"users": {"monitoring": "read-only", "1001": "operator"}, "groups": {"minter": "admin"}, "identities": {"ops": "operator"}, "default": "none"}
+func LoadPolicy(path string) (*Policy, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file policyFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("policy %s: %s", path, err)
+	}
+
+	policy := &Policy{
+		users:      make(map[uint32]Role, len(file.Users)),
+		groups:     make(map[uint32]Role, len(file.Groups)),
+		identities: file.Identities,
+		def:        file.Default,
+		ownerID:    os.Getuid(),
+		groupIDs:   userGroupIDs,
+	}
+	if policy.identities == nil {
+		policy.identities = make(map[string]Role)
+	}
+	for name, role := range file.Users {
+		id, err := lookupID(name, func(name string) (string, error) {
+			u, err := user.Lookup(name)
+			if err != nil {
+				return "", err
+			}
+			return u.Uid, nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("policy %s: %s", path, err)
+		}
+		policy.users[id] = role
+	}
+	for name, role := range file.Groups {
+		id, err := lookupID(name, func(name string) (string, error) {
+			g, err := user.LookupGroup(name)
+			if err != nil {
+				return "", err
+			}
+			return g.Gid, nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("policy %s: %s", path, err)
+		}
+		policy.groups[id] = role
+	}
+
+	return policy, nil
+}
+
+func lookupID(name string, lookup func(string) (string, error)) (uint32, error) {
+	if id, err := strconv.ParseUint(name, 10, 32); err == nil {
+		return uint32(id), nil
+	}
+	id, err := lookup(name)
+	if err != nil {
+		return 0, err
+	}
+	parsed, err := strconv.ParseUint(id, 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	return uint32(parsed), nil
+}
+
+func userGroupIDs(uid uint32) ([]string, error) {
+	u, err := user.LookupId(strconv.FormatUint(uint64(uid), 10))
+	if err != nil {
+		return nil, err
+	}
+	return u.GroupIds()
+}
+
+// Role returns the highest role granted to the credentials
+func (p *Policy) Role(creds *PeerCredentials) Role {
+	if p.ownerID >= 0 && creds.Uid == uint32(p.ownerID) {
+		return RoleAdmin
+	}
+	role := p.def
+	if userRole, ok := p.users[creds.Uid]; ok && userRole > role {
+		role = userRole
+	}
+	gids := []uint32{creds.Gid}
+	if len(p.groups) > 0 && p.groupIDs != nil {
+		// a user unknown to the group database only has its primary group
+		ids, _ := p.groupIDs(creds.Uid)
+		for _, id := range ids {
+			if gid, err := strconv.ParseUint(id, 10, 32); err == nil {
+				gids = append(gids, uint32(gid))
+			}
+		}
+	}
+	for _, gid := range gids {
+		if groupRole, ok := p.groups[gid]; ok && groupRole > role {
+			role = groupRole
+		}
+	}
+	return role
+}
+
+// IdentityRole returns the role granted to the client certificate CN
+func (p *Policy) IdentityRole(commonName string) Role {
+	if role, ok := p.identities[commonName]; ok && role > p.def {
+		return role
+	}
+	return p.def
+}
+
+func (p *Policy) authorize(ctx context.Context, method string) error {
+	pr, ok := peer.FromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "unknown peer")
+	}
+
+	var role Role
+	switch info := pr.AuthInfo.(type) {
+	case *PeerCredentials:
+		role = p.Role(info)
+	case credentials.TLSInfo:
+		commonName, err := tlsCommonName(info)
+		if err != nil {
+			return status.Error(codes.Unauthenticated, err.Error())
+		}
+		role = p.IdentityRole(commonName)
+	case anonymousCaller:
+		role = p.def
+	default:
+		return status.Error(codes.Unauthenticated, "caller credentials are not available")
+	}
+
+	required := requiredRole(method)
+	if role < required {
+		return status.Errorf(codes.PermissionDenied, "%s requires %s role, %s has %s", method, required, callerIdentity(ctx), role)
+	}
+	return nil
+}
+
+// callerIdentity describes the caller by its uid or client certificate CN
+func callerIdentity(ctx context.Context) string {
+	pr, ok := peer.FromContext(ctx)
+	if !ok {
+		return "unknown"
+	}
+	switch info := pr.AuthInfo.(type) {
+	case *PeerCredentials:
+		return fmt.Sprintf("uid:%d", info.Uid)
+	case credentials.TLSInfo:
+		if commonName, err := tlsCommonName(info); err == nil {
+			return "cn:" + commonName
+		}
+	case anonymousCaller:
+		return "anonymous"
+	}
+	return "unknown"
+}
+
+func (p *Policy) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := p.authorize(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (p *Policy) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := p.authorize(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// PeerCredentials is the AuthInfo of a process connected to the unix socket
+type PeerCredentials struct {
+	Pid int32
+	Uid uint32
+	Gid uint32
+}
+
+func (*PeerCredentials) AuthType() string {
+	return "peercred"
+}
+
+// peerCredentialsTransport attaches the SO_PEERCRED credentials of unix socket connections to their AuthInfo
+type peerCredentialsTransport struct{}
+
+func (peerCredentialsTransport) ClientHandshake(ctx context.Context, authority string, conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return conn, nil, nil
+}
+
+func (peerCredentialsTransport) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	creds, err := readPeerCredentials(conn)
+	if err != nil {
+		return nil, nil, err
+	}
+	return conn, creds, nil
+}
+
+func (peerCredentialsTransport) Info() credentials.ProtocolInfo {
+	return credentials.ProtocolInfo{SecurityProtocol: "peercred"}
+}
+
+func (t peerCredentialsTransport) Clone() credentials.TransportCredentials {
+	return t
+}
+
+func (peerCredentialsTransport) OverrideServerName(string) error {
+	return nil
+}
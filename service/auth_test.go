@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/MinterTeam/minter-node-cli/pb"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestLoadPolicy(t *testing.T) {
+	dir, err := ioutil.TempDir("", "policy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "policy.json")
+	data := `{"users": {"1001": "operator"}, "groups": {"1002": "admin"}, "default": "read-only"}`
+	if err := ioutil.WriteFile(path, []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	policy, err := LoadPolicy(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	policy.ownerID = -1
+	policy.groupIDs = func(uid uint32) ([]string, error) {
+		if uid == 1003 {
+			return []string{"1003", "1002"}, nil
+		}
+		return nil, errors.New("unknown user")
+	}
+
+	for _, tt := range []struct {
+		creds *PeerCredentials
+		role  Role
+	}{
+		{&PeerCredentials{Uid: 1000, Gid: 1000}, RoleReadOnly},
+		{&PeerCredentials{Uid: 1001, Gid: 1000}, RoleOperator},
+		{&PeerCredentials{Uid: 1001, Gid: 1002}, RoleAdmin},
+		// 1002 is a supplementary group of the user
+		{&PeerCredentials{Uid: 1003, Gid: 1003}, RoleAdmin},
+	} {
+		if role := policy.Role(tt.creds); role != tt.role {
+			t.Errorf("Role(%+v) = %s, want %s", tt.creds, role, tt.role)
+		}
+	}
+}
+
+func TestPolicyInterceptor(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("peer credentials are only supported on linux")
+	}
+
+	uid := strconv.Itoa(os.Getuid())
+	dir, err := ioutil.TempDir("", "policy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "policy.json")
+	if err := ioutil.WriteFile(path, []byte(`{"users": {"`+uid+`": "read-only"}}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	policy, err := LoadPolicy(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	policy.ownerID = -1
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	socketPath := filepath.Join(dir, "manager.sock")
+	go func() {
+		if err := StartCLIServer(socketPath, &pb.UnimplementedManagerServiceServer{}, ctx, WithPolicy(policy)); err != nil {
+			t.Log(err)
+		}
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	cc, err := grpc.Dial("passthrough:///unix:///"+socketPath, grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cc.Close()
+	client := pb.NewManagerServiceClient(cc)
+
+	_, err = client.Status(context.Background(), &empty.Empty{})
+	if code := status.Code(err); code != codes.Unimplemented {
+		t.Errorf("Status() code = %s, want %s", code, codes.Unimplemented)
+	}
+	_, err = client.PruneBlocks(context.Background(), &pb.PruneBlocksRequest{FromHeight: 1, ToHeight: 2})
+	if code := status.Code(err); code != codes.PermissionDenied {
+		t.Errorf("PruneBlocks() code = %s, want %s", code, codes.PermissionDenied)
+	}
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"github.com/tendermint/tendermint/p2p"
+	"sync"
+	"time"
+)
+
+// banList keeps the peers banned through the manager and disconnects them while their ban lasts.
+// The ban is a best-effort disconnect: the switch of the node is built without a filter the manager
+// could add to, so a banned peer can connect again until the next round of enforce disconnects it.
+// The bans are kept in memory only and are lost when the node restarts.
+type banList struct {
+	mu        sync.Mutex
+	until     map[p2p.ID]time.Time
+	enforcing bool
+
+	// persistent are the peers dialed as persistent through the manager, which the switch keeps redialing
+	persistent map[p2p.ID]bool
+}
+
+func newBanList() *banList {
+	return &banList{until: make(map[p2p.ID]time.Time), persistent: make(map[p2p.ID]bool)}
+}
+
+func (b *banList) ban(id p2p.ID, until time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.until[id] = until
+}
+
+func (b *banList) unban(id p2p.ID) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	_, ok := b.until[id]
+	delete(b.until, id)
+	return ok
+}
+
+func (b *banList) isBanned(id p2p.ID) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	until, ok := b.until[id]
+	return ok && time.Now().Before(until)
+}
+
+func (b *banList) addPersistent(id p2p.ID) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.persistent[id] = true
+}
+
+func (b *banList) isPersistent(id p2p.ID) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.persistent[id]
+}
+
+// list returns the active bans, dropping the expired ones
+func (b *banList) list() map[p2p.ID]time.Time {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.active()
+}
+
+func (b *banList) active() map[p2p.ID]time.Time {
+	now := time.Now()
+	bans := make(map[p2p.ID]time.Time, len(b.until))
+	for id, until := range b.until {
+		if !now.Before(until) {
+			delete(b.until, id)
+			continue
+		}
+		bans[id] = until
+	}
+	return bans
+}
+
+// enforce disconnects banned peers that connect to the switch again and removes them from the address book,
+// if any, which the PEX reactor may have learnt them again into, until there are no active bans left.
+// Peers are stopped gracefully, which unlike errors does not make the switch redial persistent peers.
+func (b *banList) enforce(sw PeerSwitch, book AddressBook, period time.Duration) {
+	b.mu.Lock()
+	if b.enforcing {
+		b.mu.Unlock()
+		return
+	}
+	b.enforcing = true
+	b.mu.Unlock()
+
+	go func() {
+		ticker := time.NewTicker(period)
+		defer ticker.Stop()
+		for {
+			for _, peer := range sw.Peers().List() {
+				if b.isBanned(peer.ID()) {
+					sw.StopPeerGracefully(peer)
+				}
+			}
+			if book != nil {
+				for id := range b.list() {
+					book.MarkBad(&p2p.NetAddress{ID: id})
+				}
+			}
+
+			<-ticker.C
+
+			b.mu.Lock()
+			if len(b.active()) == 0 {
+				b.enforcing = false
+				b.mu.Unlock()
+				return
+			}
+			b.mu.Unlock()
+		}
+	}()
+}
@@ -0,0 +1,32 @@
+package service
+
+import (
+	"github.com/tendermint/tendermint/p2p"
+	"testing"
+	"time"
+)
+
+func TestBanList(t *testing.T) {
+	bans := newBanList()
+	active := p2p.ID("0123456789abcdef0123456789abcdef01234567")
+	expired := p2p.ID("89abcdef0123456789abcdef0123456789abcdef")
+
+	bans.ban(active, time.Now().Add(time.Hour))
+	bans.ban(expired, time.Now().Add(-time.Second))
+
+	if !bans.isBanned(active) {
+		t.Errorf("peer %s should be banned", active)
+	}
+	if bans.isBanned(expired) {
+		t.Errorf("ban of peer %s should be expired", expired)
+	}
+	if list := bans.list(); len(list) != 1 {
+		t.Errorf("list() returned %d bans, want 1", len(list))
+	}
+	if !bans.unban(active) || bans.unban(active) {
+		t.Errorf("peer %s should be unbanned exactly once", active)
+	}
+	if list := bans.list(); len(list) != 0 {
+		t.Errorf("list() returned %d bans, want 0", len(list))
+	}
+}
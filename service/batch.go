@@ -0,0 +1,119 @@
+package service
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"os"
+	"strings"
+)
+
+// BatchError is returned by ExecuteBatch when some of the commands failed
+type BatchError struct {
+	// Failed is the number of failed commands
+	Failed int
+	// Line and Err describe the last failure
+	Line int
+	Err  error
+}
+
+func (e *BatchError) Error() string {
+	if e.Failed == 1 {
+		return fmt.Sprintf("line %d: %s", e.Line, e.Err)
+	}
+	return fmt.Sprintf("%d commands failed, last at line %d: %s", e.Failed, e.Line, e.Err)
+}
+
+func (e *BatchError) Unwrap() error {
+	return e.Err
+}
+
+// ExecuteBatch runs the commands read from r, one per line. Empty lines and lines starting with # are skipped,
+// `set -e` and `set +e` lines switch stopping at the first failed command, which stopOnError sets initially.
+// Errors are printed to stderr as they happen and the returned error reports the failures.
+func (mc *ManagerConsole) ExecuteBatch(r io.Reader, stopOnError bool) error {
+	var batchErr *BatchError
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		switch strings.Join(strings.Fields(text), " ") {
+		case "set -e":
+			stopOnError = true
+			continue
+		case "set +e":
+			stopOnError = false
+			continue
+		}
+
+		err := mc.execute(strings.Fields(text))
+		if err == errExit {
+			break
+		}
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "line %d: %s\n", line, err)
+			if batchErr == nil {
+				batchErr = &BatchError{}
+			}
+			batchErr.Failed++
+			batchErr.Line, batchErr.Err = line, err
+			if stopOnError {
+				return batchErr
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	if batchErr != nil {
+		return batchErr
+	}
+	return nil
+}
+
+// ExecuteFile runs ExecuteBatch over the file, or over stdin if the path is "-"
+func (mc *ManagerConsole) ExecuteFile(path string, stopOnError bool) error {
+	if path == "-" {
+		return mc.ExecuteBatch(os.Stdin, stopOnError)
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return mc.ExecuteBatch(file, stopOnError)
+}
+
+// Exit codes of the console, see ExitCode
+const (
+	ExitOK          = 0
+	ExitFailure     = 1
+	ExitTimeout     = 3
+	ExitUnavailable = 4
+)
+
+// ExitCode is the process exit code for the result of Execute, ExecuteBatch or ExecuteFile:
+// ExitTimeout or ExitUnavailable when the call to the node timed out or could not reach it,
+// ExitFailure for other errors. A batch exits with the code of its last failure.
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitOK
+	}
+	var grpcErr interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &grpcErr) {
+		switch grpcErr.GRPCStatus().Code() {
+		case codes.DeadlineExceeded:
+			return ExitTimeout
+		case codes.Unavailable:
+			return ExitUnavailable
+		}
+	}
+	return ExitFailure
+}
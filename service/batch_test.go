@@ -0,0 +1,49 @@
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestExecuteBatch(t *testing.T) {
+	console, err := ConfigureManagerConsole(filepath.Join(os.TempDir(), "missing-manager.sock"), WithHistory("", 0, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		name        string
+		script      string
+		stopOnError bool
+		failed      int
+		line        int
+	}{
+		{"comments", "# status\n\n   # net_info\n", false, 0, 0},
+		{"continue", "status\n# comment\nnet_info\n", false, 2, 3},
+		{"stop", "status\nnet_info\n", true, 1, 1},
+		{"set -e", "set -e\n\nstatus\nnet_info\n", false, 1, 3},
+		{"set +e", "set +e\nstatus\nnet_info\n", true, 2, 3},
+		{"exit", "exit\nstatus\n", true, 0, 0},
+	} {
+		err := console.ExecuteBatch(strings.NewReader(tt.script), tt.stopOnError)
+		if tt.failed == 0 {
+			if err != nil {
+				t.Errorf("%s: ExecuteBatch() = %v", tt.name, err)
+			}
+			continue
+		}
+		batchErr, ok := err.(*BatchError)
+		if !ok {
+			t.Errorf("%s: ExecuteBatch() = %v, want *BatchError", tt.name, err)
+			continue
+		}
+		if batchErr.Failed != tt.failed || batchErr.Line != tt.line {
+			t.Errorf("%s: failed %d at line %d, want %d at line %d", tt.name, batchErr.Failed, batchErr.Line, tt.failed, tt.line)
+		}
+		if ExitCode(err) == 0 {
+			t.Errorf("%s: ExitCode() = 0", tt.name)
+		}
+	}
+}
@@ -1,8 +1,11 @@
 package service
 
 import (
+	"bufio"
 	"context"
-	"encoding/json"
+	"crypto/tls"
+	"errors"
+	"flag"
 	"fmt"
 	"github.com/MinterTeam/minter-node-cli/pb"
 	"github.com/c-bata/go-prompt"
@@ -10,20 +13,41 @@ import (
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/urfave/cli/v2"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+	"io"
+	"io/ioutil"
 	"os"
+	"os/signal"
+	"strconv"
 	"strings"
+	"time"
 )
 
 type ManagerConsole struct {
-	cli *cli.App
+	cli     *cli.App
+	history *History
+	nodes   *consoleNodes
 }
 
 func NewManagerConsole(cli *cli.App) *ManagerConsole {
-	return &ManagerConsole{cli: cli}
+	return &ManagerConsole{cli: cli, history: &History{}}
 }
 
+// errExit is returned by the exit command
+var errExit = errors.New("exit")
+
 func (mc *ManagerConsole) Execute(args []string) error {
-	return mc.cli.Run(append(make([]string, 1, len(args)+1), args...))
+	err := mc.execute(args)
+	if err == errExit {
+		os.Exit(0)
+	}
+	return err
+}
+
+func (mc *ManagerConsole) execute(args []string) error {
+	return describeError(mc.cli.Run(append(make([]string, 1, len(args)+1), args...)))
 }
 
 func completer(commands cli.Commands) prompt.Completer {
@@ -51,8 +75,33 @@ func completer(commands cli.Commands) prompt.Completer {
 				continue
 			}
 
+			// commands with subcommands complete the subcommand first and then its flags
+			if len(command.Subcommands) > 0 {
+				if len(wordsBefore) == 2 {
+					subcommandHints := make([]prompt.Suggest, 0, len(command.Subcommands))
+					for _, subcommand := range command.Subcommands {
+						subcommandHints = append(subcommandHints, prompt.Suggest{Text: subcommand.Name, Description: subcommand.Usage})
+					}
+					return prompt.FilterHasPrefix(subcommandHints, wordsBefore[1], true)
+				}
+				var subcommand *cli.Command
+				for _, c := range command.Subcommands {
+					if c.HasName(wordsBefore[1]) {
+						subcommand = c
+					}
+				}
+				if subcommand == nil {
+					return flagHints
+				}
+				command = subcommand
+			}
+
+			lastWord := wordsBefore[len(wordsBefore)-1]
 			for _, flag := range command.VisibleFlags() {
 				tag := "--" + flag.Names()[0]
+				if suggest, ok := flag.(*suggestFlag); ok && strings.HasPrefix(lastWord, tag+"=") {
+					return prompt.FilterHasPrefix(suggest.suggestions(tag+"="), lastWord, true)
+				}
 				if strings.Contains(before, tag) {
 					continue
 				}
@@ -75,35 +124,156 @@ func completer(commands cli.Commands) prompt.Completer {
 	}
 }
 
+// suggestFlag is a string flag whose values are completed in the console
+type suggestFlag struct {
+	*cli.StringFlag
+	suggest func() []prompt.Suggest
+}
+
+func (f *suggestFlag) suggestions(prefix string) []prompt.Suggest {
+	suggestions := f.suggest()
+	for i := range suggestions {
+		suggestions[i].Text = prefix + suggestions[i].Text
+	}
+	return suggestions
+}
+
 func (mc *ManagerConsole) Cli() {
 	completer := completer(mc.cli.Commands)
-	var history []string
 	for {
+		search := &historySearch{history: mc.history}
+		searchKeys, searchCodes := search.keyBinds()
 		t := prompt.Input(">>> ", completer,
-			prompt.OptionHistory(history),
+			prompt.OptionHistory(mc.history.Lines()),
 			prompt.OptionShowCompletionAtStart(),
+			prompt.OptionLivePrefix(func() (string, bool) {
+				if prefix, ok := search.prefix(); ok {
+					return prefix, true
+				}
+				return mc.prefix(), true
+			}),
+			prompt.OptionAddKeyBind(searchKeys...),
+			prompt.OptionAddASCIICodeBind(searchCodes...),
 		)
+
+		// !n runs the n-th line listed by the history command
+		if line := strings.TrimSpace(t); strings.HasPrefix(line, "!") {
+			n, err := strconv.Atoi(line[1:])
+			previous, ok := mc.history.Get(n)
+			if err != nil || !ok {
+				_, _ = fmt.Fprintf(os.Stderr, "%s: event not found\n", line)
+				continue
+			}
+			fmt.Println(previous)
+			t = previous
+		}
+
+		if err := mc.history.Add(t); err != nil {
+			_, _ = fmt.Fprintln(os.Stderr, "history:", err)
+		}
 		if err := mc.Execute(strings.Fields(t)); err != nil {
 			_, _ = fmt.Fprintln(os.Stderr, err)
 		}
-		history = append(history, t)
 	}
 }
 
-func ConfigureManagerConsole(socketPath string) (*ManagerConsole, error) {
-	cc, err := grpc.Dial("passthrough:///unix:///"+socketPath, grpc.WithInsecure())
+// prefix is the prompt with the connection status, naming the current node when the console has several
+func (mc *ManagerConsole) prefix() string {
+	if mc.nodes == nil {
+		return ">>> "
+	}
+	if len(mc.nodes.names) < 2 {
+		return "[" + mc.nodes.currentStatus() + "] >>> "
+	}
+	return "[" + mc.nodes.currentName() + ": " + mc.nodes.currentStatus() + "] >>> "
+}
+
+type consoleOptions struct {
+	tlsAddress     string
+	tlsConfig      *tls.Config
+	historyPath    string
+	historyLimit   int
+	secretPatterns []string
+	profiles       *Profiles
+	queue          time.Duration
+	timeout        time.Duration
+}
+
+// ConsoleOption configures the connection of the console to the manager
+type ConsoleOption func(*consoleOptions)
+
+// WithTLSEndpoint dials the manager at host:port with mutual TLS instead of the unix socket,
+// the config should present a client certificate (see NewClientTLSConfig)
+func WithTLSEndpoint(address string, config *tls.Config) ConsoleOption {
+	return func(o *consoleOptions) {
+		o.tlsAddress = address
+		o.tlsConfig = config
+	}
+}
+
+// WithHistory persists the console history to the file, keeping the last limit lines
+// and never recording lines matching the secret patterns. An empty path keeps the history in memory.
+func WithHistory(path string, limit int, secretPatterns []string) ConsoleOption {
+	return func(o *consoleOptions) {
+		o.historyPath = path
+		o.historyLimit = limit
+		o.secretPatterns = secretPatterns
+	}
+}
+
+// WithProfiles makes the nodes of the profiles available to the use command and the --all and --nodes selectors,
+// besides the local node given by the socket path or the TLS endpoint
+func WithProfiles(profiles *Profiles) ConsoleOption {
+	return func(o *consoleOptions) {
+		o.profiles = profiles
+	}
+}
+
+// WithQueue makes commands wait up to maxWait for the node to come back when it is not connected,
+// instead of failing at once
+func WithQueue(maxWait time.Duration) ConsoleOption {
+	return func(o *consoleOptions) {
+		o.queue = maxWait
+	}
+}
+
+// WithTimeout bounds the calls of the commands to the node, 0 for no deadline,
+// the global and the command --timeout flags override it
+func WithTimeout(timeout time.Duration) ConsoleOption {
+	return func(o *consoleOptions) {
+		o.timeout = timeout
+	}
+}
+
+func ConfigureManagerConsole(socketPath string, opts ...ConsoleOption) (*ManagerConsole, error) {
+	options := &consoleOptions{
+		historyPath:    DefaultHistoryPath(),
+		historyLimit:   DefaultHistoryLimit,
+		secretPatterns: DefaultSecretPatterns,
+		timeout:        DefaultTimeout,
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	history, err := LoadHistory(options.historyPath, options.historyLimit, options.secretPatterns)
 	if err != nil {
 		return nil, err
 	}
 
-	client := pb.NewManagerServiceClient(cc)
+	nodes, err := dialNodes(socketPath, options)
+	if err != nil {
+		return nil, err
+	}
 
 	app := cli.NewApp()
 	app.CommandNotFound = func(ctx *cli.Context, cmd string) {
 		fmt.Println(fmt.Sprintf("No help topic for '%v'", cmd))
 	}
 	app.UseShortOptionHandling = true
-	jsonFlag := &cli.BoolFlag{Name: "json", Aliases: []string{"j"}, Required: false, Usage: "echo in json format"}
+	app.Flags = []cli.Flag{outputFlag("text"), timeoutFlag(options.timeout)}
+	jsonFlag := &cli.BoolFlag{Name: "json", Aliases: []string{"j"}, Required: false, Usage: "echo in json format, same as --output=json"}
+	commandTimeoutFlag := timeoutFlag(options.timeout)
 	app.Commands = []*cli.Command{
 		{
 			Name:    "dial_peer",
@@ -112,9 +282,13 @@ func ConfigureManagerConsole(socketPath string) (*ManagerConsole, error) {
 			Flags: []cli.Flag{
 				&cli.StringFlag{Name: "address", Aliases: []string{"a"}, Required: true, Usage: "id@ip:port"},
 				&cli.BoolFlag{Name: "persistent", Aliases: []string{"p"}, Required: false},
+				commandTimeoutFlag,
 			},
 			Action: func(c *cli.Context) error {
-				_, err := client.DealPeer(context.Background(), &pb.DealPeerRequest{
+				ctx, cancel := callContext(c, options.timeout)
+				defer cancel()
+
+				_, err := nodes.client().DealPeer(ctx, &pb.DealPeerRequest{
 					Address:    c.String("address"),
 					Persistent: c.Bool("persistent"),
 				})
@@ -125,6 +299,266 @@ func ConfigureManagerConsole(socketPath string) (*ManagerConsole, error) {
 				return nil
 			},
 		},
+		{
+			Name:    "stop_peer",
+			Aliases: []string{"sp"},
+			Usage:   "disconnect a peer",
+			Flags: []cli.Flag{
+				&suggestFlag{
+					StringFlag: &cli.StringFlag{Name: "id", Aliases: []string{"i"}, Required: true, Usage: "node id"},
+					suggest:    connectedPeers(nodes),
+				},
+				commandTimeoutFlag,
+			},
+			Action: func(c *cli.Context) error {
+				ctx, cancel := callContext(c, options.timeout)
+				defer cancel()
+
+				_, err := nodes.client().StopPeer(ctx, &pb.StopPeerRequest{
+					Id: c.String("id"),
+				})
+				if err != nil {
+					return err
+				}
+				fmt.Println("OK")
+				return nil
+			},
+		},
+		{
+			Name:    "ban_peer",
+			Aliases: []string{"bp"},
+			Usage:   "disconnect a peer whenever it connects for the duration, until the node restarts",
+			Flags: []cli.Flag{
+				&suggestFlag{
+					StringFlag: &cli.StringFlag{Name: "id", Aliases: []string{"i"}, Required: true, Usage: "node id"},
+					suggest:    connectedPeers(nodes),
+				},
+				&cli.DurationFlag{Name: "duration", Aliases: []string{"d"}, Required: true, Usage: "ban duration, e.g. 1h"},
+				commandTimeoutFlag,
+			},
+			Action: func(c *cli.Context) error {
+				ctx, cancel := callContext(c, options.timeout)
+				defer cancel()
+
+				_, err := nodes.client().BanPeer(ctx, &pb.BanPeerRequest{
+					Id:       c.String("id"),
+					Duration: int64(c.Duration("duration")),
+				})
+				if err != nil {
+					return err
+				}
+				fmt.Println("OK")
+				return nil
+			},
+		},
+		{
+			Name:    "unban_peer",
+			Aliases: []string{"up"},
+			Usage:   "lift a peer ban",
+			Flags: []cli.Flag{
+				&suggestFlag{
+					StringFlag: &cli.StringFlag{Name: "id", Aliases: []string{"i"}, Required: true, Usage: "node id"},
+					suggest:    bannedPeers(nodes),
+				},
+				commandTimeoutFlag,
+			},
+			Action: func(c *cli.Context) error {
+				ctx, cancel := callContext(c, options.timeout)
+				defer cancel()
+
+				_, err := nodes.client().UnbanPeer(ctx, &pb.UnbanPeerRequest{
+					Id: c.String("id"),
+				})
+				if err != nil {
+					return err
+				}
+				fmt.Println("OK")
+				return nil
+			},
+		},
+		{
+			Name:    "banned_peers",
+			Aliases: []string{"bps"},
+			Usage:   "display banned peers",
+			Flags: []cli.Flag{
+				jsonFlag,
+				outputFlag(""),
+				commandTimeoutFlag,
+			},
+			Action: func(c *cli.Context) error {
+				ctx, cancel := callContext(c, options.timeout)
+				defer cancel()
+
+				response, err := nodes.client().ListBannedPeers(ctx, &empty.Empty{})
+				if err != nil {
+					return err
+				}
+				return printResponse(c, response)
+			},
+		},
+		{
+			Name:    "address_book",
+			Aliases: []string{"ab"},
+			Usage:   "display or edit the book of known peer addresses",
+			Subcommands: []*cli.Command{
+				{
+					Name:  "list",
+					Usage: "display the addresses last saved by the node with their bucket, dial attempts and last success",
+					Flags: []cli.Flag{
+						jsonFlag,
+						outputFlag(""),
+						commandTimeoutFlag,
+					},
+					Action: func(c *cli.Context) error {
+						ctx, cancel := callContext(c, options.timeout)
+						defer cancel()
+
+						response, err := nodes.client().AddressBook(ctx, &empty.Empty{})
+						if err != nil {
+							return err
+						}
+						if response.SavedAt == "" {
+							_, _ = fmt.Fprintln(os.Stderr, "warning: the node has not saved its address book yet, run address_book save to list it")
+						} else {
+							_, _ = fmt.Fprintf(os.Stderr, "address book saved at %s, later changes are listed after address_book save\n", response.SavedAt)
+						}
+						return printResponse(c, response)
+					},
+				},
+				{
+					Name:  "add",
+					Usage: "add an address to the new buckets",
+					Flags: []cli.Flag{
+						&cli.StringFlag{Name: "address", Aliases: []string{"a"}, Required: true, Usage: "id@ip:port"},
+						commandTimeoutFlag,
+					},
+					Action: func(c *cli.Context) error {
+						ctx, cancel := callContext(c, options.timeout)
+						defer cancel()
+
+						_, err := nodes.client().AddAddress(ctx, &pb.AddAddressRequest{Address: c.String("address")})
+						if err != nil {
+							return err
+						}
+						fmt.Println("OK")
+						return nil
+					},
+				},
+				{
+					Name:  "remove",
+					Usage: "remove the address of a peer",
+					Flags: []cli.Flag{
+						&suggestFlag{
+							StringFlag: &cli.StringFlag{Name: "id", Aliases: []string{"i"}, Required: true, Usage: "node id"},
+							suggest:    bookAddresses(nodes),
+						},
+						commandTimeoutFlag,
+					},
+					Action: func(c *cli.Context) error {
+						ctx, cancel := callContext(c, options.timeout)
+						defer cancel()
+
+						_, err := nodes.client().RemoveAddress(ctx, &pb.AddressRequest{Id: c.String("id")})
+						if err != nil {
+							return err
+						}
+						fmt.Println("OK")
+						return nil
+					},
+				},
+				{
+					Name:  "good",
+					Usage: "mark the address of a peer good, moving it to the old buckets",
+					Flags: []cli.Flag{
+						&suggestFlag{
+							StringFlag: &cli.StringFlag{Name: "id", Aliases: []string{"i"}, Required: true, Usage: "node id"},
+							suggest:    bookAddresses(nodes),
+						},
+						commandTimeoutFlag,
+					},
+					Action: func(c *cli.Context) error {
+						ctx, cancel := callContext(c, options.timeout)
+						defer cancel()
+
+						_, err := nodes.client().MarkAddressGood(ctx, &pb.AddressRequest{Id: c.String("id")})
+						if err != nil {
+							return err
+						}
+						fmt.Println("OK")
+						return nil
+					},
+				},
+				{
+					Name:  "bad",
+					Usage: "mark the address of a peer bad, which removes it from the book",
+					Flags: []cli.Flag{
+						&suggestFlag{
+							StringFlag: &cli.StringFlag{Name: "id", Aliases: []string{"i"}, Required: true, Usage: "node id"},
+							suggest:    bookAddresses(nodes),
+						},
+						commandTimeoutFlag,
+					},
+					Action: func(c *cli.Context) error {
+						ctx, cancel := callContext(c, options.timeout)
+						defer cancel()
+
+						_, err := nodes.client().MarkAddressBad(ctx, &pb.AddressRequest{Id: c.String("id")})
+						if err != nil {
+							return err
+						}
+						fmt.Println("OK")
+						return nil
+					},
+				},
+				{
+					Name:  "save",
+					Usage: "write the address book to disk now",
+					Flags: []cli.Flag{
+						commandTimeoutFlag,
+					},
+					Action: func(c *cli.Context) error {
+						ctx, cancel := callContext(c, options.timeout)
+						defer cancel()
+
+						_, err := nodes.client().SaveAddressBook(ctx, &empty.Empty{})
+						if err != nil {
+							return err
+						}
+						fmt.Println("OK")
+						return nil
+					},
+				},
+			},
+		},
+		{
+			Name:    "audit_log",
+			Aliases: []string{"al"},
+			Usage:   "display audited calls",
+			Flags: []cli.Flag{
+				jsonFlag,
+				outputFlag(""),
+				&cli.StringFlag{Name: "since", Aliases: []string{"s"}, Required: false, Usage: "duration like 24h or RFC3339 time"},
+				&cli.StringFlag{Name: "method", Aliases: []string{"m"}, Required: false, Usage: "method name, e.g. PruneBlocks"},
+				commandTimeoutFlag,
+			},
+			Action: func(c *cli.Context) error {
+				ctx, cancel := callContext(c, options.timeout)
+				defer cancel()
+
+				since := c.String("since")
+				if duration, err := time.ParseDuration(since); err == nil {
+					since = time.Now().Add(-duration).Format(time.RFC3339)
+				}
+				response, err := nodes.client().AuditLog(ctx, &pb.AuditLogRequest{
+					Since:  since,
+					Method: c.String("method"),
+				})
+				if err != nil {
+					return err
+				}
+				return printResponse(c, response)
+			},
+		},
 		{
 			Name:    "prune_blocks",
 			Aliases: []string{"pb"},
@@ -134,13 +568,38 @@ func ConfigureManagerConsole(socketPath string) (*ManagerConsole, error) {
 				&cli.IntFlag{Name: "to", Aliases: []string{"t"}, Required: true},
 			},
 			Action: func(c *cli.Context) error {
-				_, err := client.PruneBlocks(context.Background(), &pb.PruneBlocksRequest{
+				ctx, cancel := contextWithInterrupt(context.Background())
+				defer cancel()
+
+				stream, err := nodes.client().PruneBlocksStream(ctx, &pb.PruneBlocksRequest{
 					FromHeight: c.Int64("from"),
 					ToHeight:   c.Int64("to"),
 				})
 				if err != nil {
 					return err
 				}
+
+				progress := false
+				for {
+					recv, err := stream.Recv()
+					if err == io.EOF {
+						break
+					}
+					if err != nil {
+						if progress {
+							fmt.Println()
+						}
+						if status.Code(err) == codes.Canceled {
+							return errors.New("pruning interrupted")
+						}
+						return err
+					}
+					fmt.Printf("\r%-80s", pruneProgress(recv))
+					progress = true
+				}
+				if progress {
+					fmt.Println()
+				}
 				fmt.Println("OK")
 				return nil
 			},
@@ -149,47 +608,408 @@ func ConfigureManagerConsole(socketPath string) (*ManagerConsole, error) {
 			Name:    "status",
 			Aliases: []string{"s"},
 			Usage:   "display the current status of the blockchain",
-			Flags: []cli.Flag{
+			Flags: append([]cli.Flag{
 				jsonFlag,
-			},
+				outputFlag(""),
+				&cli.BoolFlag{Name: "watch", Aliases: []string{"w"}, Required: false, Usage: "redraw the status on every new block"},
+				&cli.DurationFlag{Name: "interval", Aliases: []string{"i"}, Required: false, Usage: "redraw the status at the interval instead of every block"},
+				commandTimeoutFlag,
+			}, nodesFlags()...),
 			Action: func(c *cli.Context) error {
-				response, err := client.Status(context.Background(), &empty.Empty{})
+				ctx, cancel := callContext(c, options.timeout)
+				defer cancel()
+
+				names, err := nodes.selected(c)
 				if err != nil {
 					return err
 				}
-				if c.Bool("json") {
-					bytes, err := json.Marshal(response)
-					if err != nil {
+				if names != nil {
+					results := nodes.fanOut(ctx, names, func(ctx context.Context, client pb.ManagerServiceClient) (proto.Message, error) {
+						return client.Status(ctx, &empty.Empty{})
+					})
+					if err := printResponseAs(c, statusOfNodes(results), "table"); err != nil {
 						return err
 					}
-					fmt.Println(string(bytes))
-					return nil
+					return nodesError(results)
 				}
-				fmt.Println(proto.MarshalTextString(response))
-				return nil
+				if c.Bool("watch") {
+					return watchStatus(c, nodes.client(), c.Duration("interval"))
+				}
+				response, err := nodes.client().Status(ctx, &empty.Empty{})
+				if err != nil {
+					return err
+				}
+				return printResponse(c, response)
 			},
 		},
 		{
 			Name:    "net_info",
 			Aliases: []string{"ni"},
 			Usage:   "display network data",
+			Flags: append([]cli.Flag{
+				jsonFlag,
+				outputFlag(""),
+				commandTimeoutFlag,
+			}, nodesFlags()...),
+			Action: func(c *cli.Context) error {
+				ctx, cancel := callContext(c, options.timeout)
+				defer cancel()
+
+				names, err := nodes.selected(c)
+				if err != nil {
+					return err
+				}
+				if names != nil {
+					results := nodes.fanOut(ctx, names, func(ctx context.Context, client pb.ManagerServiceClient) (proto.Message, error) {
+						return client.NetInfo(ctx, &empty.Empty{})
+					})
+					if err := printResponseAs(c, netInfoOfNodes(results), "table"); err != nil {
+						return err
+					}
+					return nodesError(results)
+				}
+				response, err := nodes.client().NetInfo(ctx, &empty.Empty{})
+				if err != nil {
+					return err
+				}
+				return printResponse(c, response)
+			},
+		},
+		{
+			Name:      "use",
+			Usage:     "switch to the node of the profiles, or list the nodes",
+			ArgsUsage: "[node]",
+			Action: func(c *cli.Context) error {
+				if c.NArg() == 0 {
+					current := nodes.currentName()
+					for _, name := range nodes.names {
+						marker := " "
+						if name == current {
+							marker = "*"
+						}
+						fmt.Printf("%s %s\n", marker, name)
+					}
+					return nil
+				}
+				if c.NArg() > 1 {
+					return errors.New("expected one node")
+				}
+				return nodes.use(c.Args().First())
+			},
+		},
+		{
+			Name:    "mempool",
+			Aliases: []string{"mp"},
+			Usage:   "display unconfirmed transactions",
 			Flags: []cli.Flag{
 				jsonFlag,
+				outputFlag(""),
+				&cli.IntFlag{Name: "limit", Aliases: []string{"l"}, Required: false, Usage: "maximum number of transactions, 0 for the node default"},
+				&cli.StringFlag{Name: "sender", Aliases: []string{"s"}, Required: false, Usage: "only transactions from the address, Mx..."},
+				&cli.BoolFlag{Name: "count", Aliases: []string{"c"}, Required: false, Usage: "display only the number of transactions"},
+				commandTimeoutFlag,
 			},
 			Action: func(c *cli.Context) error {
-				response, err := client.NetInfo(context.Background(), &empty.Empty{})
+				ctx, cancel := callContext(c, options.timeout)
+				defer cancel()
+
+				if c.Bool("count") {
+					response, err := nodes.client().NumUnconfirmedTxs(ctx, &empty.Empty{})
+					if err != nil {
+						return err
+					}
+					return printResponse(c, response)
+				}
+				response, err := nodes.client().UnconfirmedTxs(ctx, &pb.UnconfirmedTxsRequest{
+					Limit:  c.Int64("limit"),
+					Sender: c.String("sender"),
+				})
 				if err != nil {
 					return err
 				}
-				if c.Bool("json") {
-					bytes, err := json.Marshal(response)
+				if response.Truncated {
+					_, _ = fmt.Fprintf(os.Stderr, "warning: only the first %d of the %d unconfirmed transactions were searched for the sender\n", maxUnconfirmedTxs, response.Total)
+				}
+				return printResponse(c, response)
+			},
+		},
+		{
+			Name:    "mempool_flush",
+			Aliases: []string{"mpf"},
+			Usage:   "remove all unconfirmed transactions",
+			Flags: []cli.Flag{
+				&cli.BoolFlag{Name: "yes", Aliases: []string{"y"}, Required: false, Usage: "do not ask for confirmation"},
+				commandTimeoutFlag,
+			},
+			Action: func(c *cli.Context) error {
+				if !c.Bool("yes") {
+					ok, err := confirm("Remove all unconfirmed transactions?")
 					if err != nil {
 						return err
 					}
-					fmt.Println(string(bytes))
-					return nil
+					if !ok {
+						return errors.New("aborted")
+					}
+				}
+
+				ctx, cancel := callContext(c, options.timeout)
+				defer cancel()
+
+				_, err := nodes.client().FlushMempool(ctx, &empty.Empty{})
+				if err != nil {
+					return err
+				}
+				fmt.Println("OK")
+				return nil
+			},
+		},
+		{
+			Name:    "block",
+			Aliases: []string{"b"},
+			Usage:   "display a block with its transactions and signatures",
+			Flags: []cli.Flag{
+				jsonFlag,
+				outputFlag(""),
+				&cli.Int64Flag{Name: "height", Required: false, Usage: "block height, the latest block by default"},
+				&cli.BoolFlag{Name: "results", Aliases: []string{"r"}, Required: false, Usage: "display the results of the block transactions and its events"},
+				commandTimeoutFlag,
+			},
+			Action: func(c *cli.Context) error {
+				ctx, cancel := callContext(c, options.timeout)
+				defer cancel()
+
+				req := &pb.BlockRequest{Height: c.Int64("height")}
+				if c.Bool("results") {
+					response, err := nodes.client().BlockResults(ctx, req)
+					if err != nil {
+						return err
+					}
+					return printResponse(c, response)
+				}
+				response, err := nodes.client().Block(ctx, req)
+				if err != nil {
+					return err
+				}
+				return printResponse(c, response)
+			},
+		},
+		{
+			Name:    "tx",
+			Aliases: []string{"t"},
+			Usage:   "display a committed transaction with its result",
+			Flags: []cli.Flag{
+				jsonFlag,
+				outputFlag(""),
+				&cli.StringFlag{Name: "hash", Required: true, Usage: "transaction hash, Mt..."},
+				commandTimeoutFlag,
+			},
+			Action: func(c *cli.Context) error {
+				ctx, cancel := callContext(c, options.timeout)
+				defer cancel()
+
+				response, err := nodes.client().Tx(ctx, &pb.TxRequest{Hash: c.String("hash")})
+				if err != nil {
+					return err
+				}
+				return printResponse(c, response)
+			},
+		},
+		{
+			Name:    "validator",
+			Aliases: []string{"v"},
+			Usage:   "display the state and the signed blocks of this node as a validator",
+			Flags: []cli.Flag{
+				jsonFlag,
+				outputFlag(""),
+				&cli.Int64Flag{Name: "blocks", Aliases: []string{"n"}, Required: false, Usage: "number of the last blocks checked for signatures, 100 by default"},
+				commandTimeoutFlag,
+			},
+			Action: func(c *cli.Context) error {
+				ctx, cancel := callContext(c, options.timeout)
+				defer cancel()
+
+				response, err := nodes.client().Validator(ctx, &pb.ValidatorRequest{Blocks: c.Int64("blocks")})
+				if err != nil {
+					return err
+				}
+				if err := printResponse(c, response); err != nil {
+					return err
+				}
+				if response.MissedBlocks > 0 && response.MissedBlocks*2 > response.MaxMissedBlocks {
+					_, _ = fmt.Fprintf(os.Stderr, "warning: missed %d of the last %d blocks, the validator is jailed after missing more than %d\n",
+						response.MissedBlocks, response.MissedBlocksWindow, response.MaxMissedBlocks)
+				}
+				return nil
+			},
+		},
+		{
+			Name:    "log_level",
+			Aliases: []string{"ll"},
+			Usage:   "display or change the log levels of the node modules",
+			Subcommands: []*cli.Command{
+				{
+					Name:  "show",
+					Usage: "display the current log levels",
+					Flags: []cli.Flag{
+						jsonFlag,
+						outputFlag(""),
+						commandTimeoutFlag,
+					},
+					Action: func(c *cli.Context) error {
+						ctx, cancel := callContext(c, options.timeout)
+						defer cancel()
+
+						response, err := nodes.client().GetLogLevel(ctx, &empty.Empty{})
+						if err != nil {
+							return err
+						}
+						return printResponse(c, response)
+					},
+				},
+				{
+					Name:      "set",
+					Usage:     "change the log levels of the modules, e.g. consensus:debug,p2p:error",
+					ArgsUsage: "<module:level,...>",
+					Flags: []cli.Flag{
+						jsonFlag,
+						outputFlag(""),
+						&cli.DurationFlag{Name: "for", Aliases: []string{"f"}, Required: false, Usage: "revert to the previous levels after the duration, e.g. 10m"},
+						commandTimeoutFlag,
+					},
+					Action: func(c *cli.Context) error {
+						ctx, cancel := callContext(c, options.timeout)
+						defer cancel()
+
+						// flags stop at the first argument, --for is also accepted after the levels
+						ttl := c.Duration("for")
+						trailing := flag.NewFlagSet("for", flag.ContinueOnError)
+						trailing.SetOutput(ioutil.Discard)
+						trailing.DurationVar(&ttl, "for", ttl, "")
+						trailing.DurationVar(&ttl, "f", ttl, "")
+						if c.NArg() < 1 {
+							return errors.New("expected one module:level list")
+						}
+						if err := trailing.Parse(c.Args().Tail()); err != nil {
+							return err
+						}
+						if trailing.NArg() > 0 {
+							return errors.New("expected one module:level list")
+						}
+						response, err := nodes.client().SetLogLevel(ctx, &pb.SetLogLevelRequest{
+							Level: c.Args().First(),
+							Ttl:   int64(ttl),
+						})
+						if err != nil {
+							return err
+						}
+						return printResponse(c, response)
+					},
+				},
+			},
+		},
+		{
+			Name:    "profile",
+			Aliases: []string{"pf"},
+			Usage:   "save a pprof profile of the node for go tool pprof",
+			Flags: []cli.Flag{
+				&suggestFlag{
+					StringFlag: &cli.StringFlag{Name: "kind", Aliases: []string{"k"}, Required: false, Value: cpuProfile, Usage: strings.Join(profileKinds(), "|")},
+					suggest:    profileSuggestions,
+				},
+				&cli.Int64Flag{Name: "seconds", Aliases: []string{"s"}, Required: false, Usage: "duration of the cpu profile, 30 by default"},
+				&cli.StringFlag{Name: "file", Aliases: []string{"f"}, Required: false, Usage: "output file, <kind>.pprof by default"},
+			},
+			Action: func(c *cli.Context) error {
+				ctx, cancel := contextWithInterrupt(context.Background())
+				defer cancel()
+
+				kind := c.String("kind")
+				stream, err := nodes.client().Profile(ctx, &pb.ProfileRequest{
+					Kind:    kind,
+					Seconds: c.Int64("seconds"),
+				})
+				if err != nil {
+					return err
+				}
+				path := c.String("file")
+				if path == "" {
+					path = kind + ".pprof"
+				}
+				if err := saveProfile(stream, path); err != nil {
+					if status.Code(err) == codes.Canceled {
+						return errors.New("profiling interrupted")
+					}
+					return err
+				}
+				fmt.Printf("OK, inspect with: go tool pprof %s\n", path)
+				return nil
+			},
+		},
+		{
+			Name:    "resources",
+			Aliases: []string{"res"},
+			Usage:   "display the memory, goroutines, open files, database sizes and free disk space of the node",
+			Flags: []cli.Flag{
+				jsonFlag,
+				outputFlag(""),
+				&cli.Float64Flag{Name: "min-free", Aliases: []string{"m"}, Required: false, Value: defaultMinFreeDisk, Usage: "warn when the free disk space is below the percentage"},
+				commandTimeoutFlag,
+			},
+			Action: func(c *cli.Context) error {
+				ctx, cancel := callContext(c, options.timeout)
+				defer cancel()
+
+				response, err := nodes.client().Resources(ctx, &empty.Empty{})
+				if err != nil {
+					return err
+				}
+				if err := printResponse(c, response); err != nil {
+					return err
+				}
+				if warning := lowDiskWarning(response, c.Float64("min-free")); warning != "" {
+					_, _ = fmt.Fprintln(os.Stderr, warning)
+				}
+				return nil
+			},
+		},
+		{
+			Name:    "health",
+			Aliases: []string{"hc"},
+			Usage:   "check that the node is synced, connected and has disk space left, failing when it is not",
+			Flags: []cli.Flag{
+				jsonFlag,
+				outputFlag(""),
+				&cli.Int64Flag{Name: "min-peers", Required: false, Value: DefaultHealthRules.MinPeers, Usage: "minimum number of connected peers, 0 to skip"},
+				&cli.DurationFlag{Name: "max-block-age", Required: false, Value: DefaultHealthRules.MaxBlockAge, Usage: "maximum time since the latest block, 0 to skip"},
+				&cli.Float64Flag{Name: "min-free", Required: false, Value: DefaultHealthRules.MinFreeDisk, Usage: "minimum percentage of free disk space, 0 to skip"},
+				commandTimeoutFlag,
+			},
+			Action: func(c *cli.Context) error {
+				ctx, cancel := callContext(c, options.timeout)
+				defer cancel()
+
+				response, err := nodes.client().Health(ctx, &pb.HealthRequest{
+					MinPeers:    c.Int64("min-peers"),
+					MaxBlockAge: int64(c.Duration("max-block-age")),
+					MinFreeDisk: c.Float64("min-free"),
+				})
+				if err != nil {
+					return err
+				}
+				if err := printResponse(c, response); err != nil {
+					return err
+				}
+				return unhealthyError(response)
+			},
+		},
+		{
+			Name:    "history",
+			Aliases: []string{"h"},
+			Usage:   "display the command history, !n runs the n-th command",
+			Action: func(c *cli.Context) error {
+				for i, line := range history.Lines() {
+					fmt.Printf("%5d  %s\n", i+1, line)
 				}
-				fmt.Println(proto.MarshalTextString(response))
 				return nil
 			},
 		},
@@ -198,16 +1018,291 @@ func ConfigureManagerConsole(socketPath string) (*ManagerConsole, error) {
 			Aliases: []string{"e"},
 			Usage:   "exit",
 			Action: func(c *cli.Context) error {
-				os.Exit(0)
-				return nil
+				return errExit
 			},
 		},
 	}
 
 	for _, command := range app.Commands {
 		command.Flags = append(command.Flags, cli.HelpFlag)
+		for _, subcommand := range command.Subcommands {
+			subcommand.Flags = append(subcommand.Flags, cli.HelpFlag)
+		}
 	}
 
 	app.Setup()
-	return NewManagerConsole(app), nil
+	console := NewManagerConsole(app)
+	console.history = history
+	console.nodes = nodes
+	return console, nil
+}
+
+// dialNodes connects to the local node and to the nodes of the profiles, starting with the default one
+func dialNodes(socketPath string, options *consoleOptions) (*consoleNodes, error) {
+	nodes := newConsoleNodes()
+	dial := func(name, target string, dialOption grpc.DialOption) error {
+		conn, err := dialNodeConnection(target, options.queue, dialOption)
+		if err != nil {
+			return err
+		}
+		if err := nodes.add(name, conn); err != nil {
+			_ = conn.cc.Close()
+			return err
+		}
+		return nil
+	}
+
+	if socketPath != "" || options.tlsAddress != "" {
+		target, dialOption := "passthrough:///unix:///"+socketPath, grpc.WithInsecure()
+		if options.tlsAddress != "" {
+			target, dialOption = options.tlsAddress, grpc.WithTransportCredentials(credentials.NewTLS(options.tlsConfig))
+		}
+		if err := dial(localNode, target, dialOption); err != nil {
+			return nil, err
+		}
+	}
+
+	if options.profiles != nil {
+		for name, profile := range options.profiles.Nodes {
+			target, dialOption, err := profile.target()
+			if err == nil {
+				err = dial(name, target, dialOption)
+			}
+			if err != nil {
+				nodes.close()
+				return nil, fmt.Errorf("node %s: %s", name, err)
+			}
+		}
+		if options.profiles.Default != "" {
+			if err := nodes.use(options.profiles.Default); err != nil {
+				nodes.close()
+				return nil, err
+			}
+		}
+	}
+
+	if len(nodes.names) == 0 {
+		return nil, errors.New("no node to connect to")
+	}
+	return nodes, nil
+}
+
+// confirm asks the question on the terminal,
+// without one (e.g. in batch mode) the answer is no so that the following lines are not consumed
+func confirm(question string) (bool, error) {
+	info, err := os.Stdin.Stat()
+	if err != nil {
+		return false, err
+	}
+	if info.Mode()&os.ModeCharDevice == 0 {
+		return false, errors.New("confirmation requires a terminal, use --yes")
+	}
+	fmt.Printf("%s [y/N] ", question)
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return false, err
+	}
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes", nil
+}
+
+// saveProfile writes the streamed profile to the path, leaving no file when the stream fails
+func saveProfile(stream pb.ManagerService_ProfileClient, path string) error {
+	var data []byte
+	for {
+		recv, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		data = append(data, recv.Data...)
+	}
+	return ioutil.WriteFile(path, data, 0600)
+}
+
+func profileSuggestions() []prompt.Suggest {
+	kinds := profileKinds()
+	suggestions := make([]prompt.Suggest, 0, len(kinds))
+	for _, kind := range kinds {
+		suggestions = append(suggestions, prompt.Suggest{Text: kind})
+	}
+	return suggestions
+}
+
+// defaultMinFreeDisk is the percentage of free disk space below which the resources command warns
+const defaultMinFreeDisk = 10
+
+// lowDiskWarning describes the free disk space when it is below the percentage of the volume
+func lowDiskWarning(response *pb.ResourcesResponse, minFree float64) string {
+	if response.DiskTotal <= 0 || response.DiskFree < 0 {
+		return ""
+	}
+	free := float64(response.DiskFree) * 100 / float64(response.DiskTotal)
+	if free >= minFree {
+		return ""
+	}
+	return fmt.Sprintf("warning: only %s (%.1f%%) of disk space left for %s", formatBytes(response.DiskFree), free, response.DataDir)
+}
+
+// unhealthyError lists the failed health checks, if any
+func unhealthyError(response *pb.HealthResponse) error {
+	if response.Healthy {
+		return nil
+	}
+	var failed []string
+	for _, check := range response.Checks {
+		if !check.Healthy {
+			failed = append(failed, check.Name+": "+check.Message)
+		}
+	}
+	return fmt.Errorf("unhealthy: %s", strings.Join(failed, "; "))
+}
+
+// suggestionTimeout bounds the RPCs made while completing flag values
+const suggestionTimeout = time.Second
+
+// suggestionContext is the context of the RPCs made while completing flag values,
+// which fail at once rather than wait for a node which is not connected
+func suggestionContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(withoutQueue(context.Background()), suggestionTimeout)
+}
+
+func connectedPeers(nodes *consoleNodes) func() []prompt.Suggest {
+	return func() []prompt.Suggest {
+		ctx, cancel := suggestionContext()
+		defer cancel()
+		response, err := nodes.client().NetInfo(ctx, &empty.Empty{})
+		if err != nil {
+			return nil
+		}
+		suggestions := make([]prompt.Suggest, 0, len(response.Peers))
+		for _, peer := range response.Peers {
+			suggestions = append(suggestions, prompt.Suggest{Text: peer.NodeInfo.Id, Description: peer.NodeInfo.Moniker})
+		}
+		return suggestions
+	}
+}
+
+func bannedPeers(nodes *consoleNodes) func() []prompt.Suggest {
+	return func() []prompt.Suggest {
+		ctx, cancel := suggestionContext()
+		defer cancel()
+		response, err := nodes.client().ListBannedPeers(ctx, &empty.Empty{})
+		if err != nil {
+			return nil
+		}
+		suggestions := make([]prompt.Suggest, 0, len(response.Peers))
+		for _, peer := range response.Peers {
+			suggestions = append(suggestions, prompt.Suggest{Text: peer.Id, Description: "until " + peer.Until})
+		}
+		return suggestions
+	}
+}
+
+func bookAddresses(nodes *consoleNodes) func() []prompt.Suggest {
+	return func() []prompt.Suggest {
+		ctx, cancel := suggestionContext()
+		defer cancel()
+		response, err := nodes.client().AddressBook(ctx, &empty.Empty{})
+		if err != nil {
+			return nil
+		}
+		suggestions := make([]prompt.Suggest, 0, len(response.Addresses))
+		for _, address := range response.Addresses {
+			suggestions = append(suggestions, prompt.Suggest{Text: address.Id, Description: address.Address})
+		}
+		return suggestions
+	}
+}
+
+// contextWithInterrupt returns a context which is cancelled on Ctrl-C,
+// so that long-running commands can be stopped without leaving the console
+func contextWithInterrupt(parent context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(parent)
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	go func() {
+		select {
+		case <-interrupt:
+			cancel()
+		case <-ctx.Done():
+		}
+		signal.Stop(interrupt)
+	}()
+	return ctx, cancel
+}
+
+func watchStatus(c *cli.Context, client pb.ManagerServiceClient, interval time.Duration) error {
+	ctx, cancel := contextWithInterrupt(context.Background())
+	defer cancel()
+
+	stream, err := client.WatchStatus(ctx, &pb.WatchStatusRequest{Interval: int64(interval)})
+	if err != nil {
+		return err
+	}
+
+	var previous *pb.StatusResponse
+	for {
+		response, err := stream.Recv()
+		if status.Code(err) == codes.Canceled {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		// move the cursor home and clear the screen to redraw in place
+		fmt.Print("\033[H\033[2J")
+		fmt.Println(statusDelta(previous, response))
+		if err := printResponse(c, response); err != nil {
+			return err
+		}
+		previous = response
+	}
+}
+
+// statusDelta describes the progress of the blockchain between two statuses
+func statusDelta(previous, current *pb.StatusResponse) string {
+	if previous == nil {
+		return fmt.Sprintf("height %d", current.LatestBlockHeight)
+	}
+	delta := current.LatestBlockHeight - previous.LatestBlockHeight
+	line := fmt.Sprintf("height %d (%+d)", current.LatestBlockHeight, delta)
+	previousTime, errPrevious := time.Parse(time.RFC3339, previous.LatestBlockTime)
+	currentTime, errCurrent := time.Parse(time.RFC3339, current.LatestBlockTime)
+	if errPrevious != nil || errCurrent != nil || !currentTime.After(previousTime) {
+		return line
+	}
+	return fmt.Sprintf("%s, %.2f blocks/sec", line, float64(delta)/currentTime.Sub(previousTime).Seconds())
+}
+
+func pruneProgress(recv *pb.PruneBlocksResponse) string {
+	const width = 30
+	filled := 0
+	if recv.Total > 0 {
+		filled = int(width * recv.Current / recv.Total)
+	}
+	return fmt.Sprintf("[%s%s] %d/%d height %d, reclaimed %s, eta %s",
+		strings.Repeat("=", filled), strings.Repeat(" ", width-filled),
+		recv.Current, recv.Total, recv.Height, formatBytes(recv.BytesReclaimed),
+		time.Duration(recv.Eta).Round(time.Second))
+}
+
+func formatBytes(bytes int64) string {
+	const unit = 1024
+	abs := bytes
+	if abs < 0 {
+		abs = -abs
+	}
+	if abs < unit {
+		return fmt.Sprintf("%d B", bytes)
+	}
+	div, exp := int64(unit), 0
+	for n := abs / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(bytes)/float64(div), "KMGTPE"[exp])
 }
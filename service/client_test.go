@@ -0,0 +1,35 @@
+package service
+
+import (
+	"github.com/MinterTeam/minter-node-cli/pb"
+	"testing"
+)
+
+func TestStatusDelta(t *testing.T) {
+	previous := &pb.StatusResponse{LatestBlockHeight: 100, LatestBlockTime: "2019-11-13T11:00:00Z"}
+	current := &pb.StatusResponse{LatestBlockHeight: 110, LatestBlockTime: "2019-11-13T11:00:50Z"}
+
+	if got := statusDelta(nil, previous); got != "height 100" {
+		t.Errorf("statusDelta() = %q", got)
+	}
+	if got := statusDelta(previous, current); got != "height 110 (+10), 0.20 blocks/sec" {
+		t.Errorf("statusDelta() = %q", got)
+	}
+	if got := statusDelta(current, current); got != "height 110 (+0)" {
+		t.Errorf("statusDelta() = %q", got)
+	}
+}
+
+func TestLowDiskWarning(t *testing.T) {
+	response := &pb.ResourcesResponse{DataDir: "/minter/data", DiskFree: 5 << 30, DiskTotal: 100 << 30}
+
+	if got := lowDiskWarning(response, 10); got != "warning: only 5.0 GiB (5.0%) of disk space left for /minter/data" {
+		t.Errorf("lowDiskWarning() = %q", got)
+	}
+	if got := lowDiskWarning(response, 5); got != "" {
+		t.Errorf("lowDiskWarning() = %q", got)
+	}
+	if got := lowDiskWarning(&pb.ResourcesResponse{DiskFree: -1, DiskTotal: -1}, 10); got != "" {
+		t.Errorf("lowDiskWarning() = %q", got)
+	}
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/status"
+	"os"
+	"sync"
+	"time"
+)
+
+// reconnectBackoff is short compared to the gRPC default, as a restarted node is expected back within seconds
+var reconnectBackoff = grpc.ConnectParams{
+	Backoff: backoff.Config{
+		BaseDelay:  500 * time.Millisecond,
+		Multiplier: 1.6,
+		Jitter:     0.2,
+		MaxDelay:   10 * time.Second,
+	},
+	MinConnectTimeout: 5 * time.Second,
+}
+
+// nodeConnection watches the state of the connection to a manager, which redials with backoff when it is lost.
+// With a queue duration, calls made while the node is not connected wait for it instead of failing.
+type nodeConnection struct {
+	cc    *grpc.ClientConn
+	queue time.Duration
+
+	mu        sync.Mutex
+	state     connectivity.State
+	connected bool
+}
+
+func dialNodeConnection(target string, queue time.Duration, opts ...grpc.DialOption) (*nodeConnection, error) {
+	c := &nodeConnection{queue: queue}
+	opts = append(opts,
+		grpc.WithConnectParams(reconnectBackoff),
+		grpc.WithUnaryInterceptor(c.unaryInterceptor),
+		grpc.WithStreamInterceptor(c.streamInterceptor),
+	)
+	cc, err := grpc.Dial(target, opts...)
+	if err != nil {
+		return nil, err
+	}
+	c.cc = cc
+	c.setState(cc.GetState())
+	go c.watch()
+	return c, nil
+}
+
+func (c *nodeConnection) watch() {
+	for {
+		state := c.cc.GetState()
+		c.setState(state)
+		if state == connectivity.Shutdown {
+			return
+		}
+		c.cc.WaitForStateChange(context.Background(), state)
+	}
+}
+
+func (c *nodeConnection) setState(state connectivity.State) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.state = state
+	if state == connectivity.Ready {
+		c.connected = true
+	}
+}
+
+// status describes the connection as connected, connecting, reconnecting, down or closed
+func (c *nodeConnection) status() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch c.state {
+	case connectivity.Ready:
+		return "connected"
+	case connectivity.TransientFailure:
+		return "down"
+	case connectivity.Shutdown:
+		return "closed"
+	}
+	if c.connected {
+		return "reconnecting"
+	}
+	return "connecting"
+}
+
+type withoutQueueKey struct{}
+
+// withoutQueue makes the calls of the context fail at once while the node is not connected instead of being queued,
+// for the calls made in the background of the prompt, such as completions
+func withoutQueue(ctx context.Context) context.Context {
+	return context.WithValue(ctx, withoutQueueKey{}, true)
+}
+
+// waitReady waits up to the queue duration for the connection to be ready, redialing at once.
+// It returns immediately without a queue duration or when the connection is ready,
+// and fails immediately for the contexts of withoutQueue.
+func (c *nodeConnection) waitReady(ctx context.Context) error {
+	state := c.cc.GetState()
+	if state == connectivity.Ready {
+		return nil
+	}
+	if ctx.Value(withoutQueueKey{}) != nil {
+		return status.Errorf(codes.Unavailable, "node is %s", c.status())
+	}
+	if c.queue <= 0 {
+		return nil
+	}
+	_, _ = fmt.Fprintf(os.Stderr, "node is %s, waiting up to %s for it (Ctrl-C to cancel)\n", c.status(), c.queue)
+
+	ctx, cancel := contextWithInterrupt(ctx)
+	defer cancel()
+	ctx, cancelTimeout := context.WithTimeout(ctx, c.queue)
+	defer cancelTimeout()
+
+	c.cc.ResetConnectBackoff()
+	for state != connectivity.Ready {
+		if state == connectivity.Shutdown {
+			return status.Error(codes.Canceled, "connection is closed")
+		}
+		if !c.cc.WaitForStateChange(ctx, state) {
+			if ctx.Err() == context.DeadlineExceeded {
+				return status.Errorf(codes.Unavailable, "node is still %s after %s", c.status(), c.queue)
+			}
+			return status.Error(codes.Canceled, "cancelled while waiting for the node")
+		}
+		state = c.cc.GetState()
+	}
+	return nil
+}
+
+func (c *nodeConnection) unaryInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if err := c.waitReady(ctx); err != nil {
+		return err
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+func (c *nodeConnection) streamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	if err := c.waitReady(ctx); err != nil {
+		return nil, err
+	}
+	return streamer(ctx, desc, cc, method, opts...)
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"github.com/MinterTeam/minter-node-cli/pb"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func waitConnectionStatus(t *testing.T, conn *nodeConnection, connected bool) string {
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		s := conn.status()
+		if (s == "connected") == connected {
+			return s
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("status() = %s after 5s", s)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestNodeConnection(t *testing.T) {
+	dir, err := ioutil.TempDir("", "connection")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	b := newTestBackend(t)
+	socketPath := filepath.Join(dir, "manager.sock")
+	serve := func(ctx context.Context) chan error {
+		errs := make(chan error, 1)
+		go func() {
+			errs <- StartCLIServer(socketPath, NewManagerWith(b.blockchain, b.rpc, b.node, b.cfg), ctx)
+		}()
+		return errs
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errs := serve(ctx)
+	time.Sleep(10 * time.Millisecond)
+
+	conn, err := dialNodeConnection("passthrough:///unix:///"+socketPath, 5*time.Second, grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.cc.Close()
+	if s := conn.status(); s != "connecting" {
+		t.Errorf("status() before the first call = %s", s)
+	}
+	client := pb.NewManagerServiceClient(conn.cc)
+	if _, err := client.Status(context.Background(), &empty.Empty{}); err != nil {
+		t.Fatal(err)
+	}
+	waitConnectionStatus(t, conn, true)
+
+	cancel()
+	if err := <-errs; err != nil {
+		t.Fatal(err)
+	}
+	if s := waitConnectionStatus(t, conn, false); s != "reconnecting" && s != "down" {
+		t.Errorf("status() after the restart = %s", s)
+	}
+
+	// calls without queue, as completions, fail at once
+	start := time.Now()
+	if _, err := client.Status(withoutQueue(context.Background()), &empty.Empty{}); status.Code(err) != codes.Unavailable || time.Since(start) > time.Second {
+		t.Errorf("Status() without queue = %v after %s", err, time.Since(start))
+	}
+
+	// the call is queued until the manager is back
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	time.AfterFunc(200*time.Millisecond, func() { serve(ctx) })
+	if _, err := client.Status(context.Background(), &empty.Empty{}); err != nil {
+		t.Fatal(err)
+	}
+	waitConnectionStatus(t, conn, true)
+}
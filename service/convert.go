@@ -0,0 +1,131 @@
+package service
+
+import (
+	"fmt"
+	minterTypes "github.com/MinterTeam/minter-go-node/core/types"
+	"github.com/MinterTeam/minter-go-node/version"
+	"github.com/MinterTeam/minter-node-cli/pb"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	cryptoAmino "github.com/tendermint/tendermint/crypto/encoding/amino"
+	"github.com/tendermint/tendermint/libs/flowrate"
+	"github.com/tendermint/tendermint/p2p"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	"time"
+)
+
+func convertStatus(resultStatus *ctypes.ResultStatus, keepLastStates int64) *pb.StatusResponse {
+	return &pb.StatusResponse{
+		Version:           version.Version,
+		LatestBlockHash:   fmt.Sprintf("%X", resultStatus.SyncInfo.LatestBlockHash),
+		LatestAppHash:     fmt.Sprintf("%X", resultStatus.SyncInfo.LatestAppHash),
+		LatestBlockHeight: resultStatus.SyncInfo.LatestBlockHeight,
+		LatestBlockTime:   resultStatus.SyncInfo.LatestBlockTime.Format(time.RFC3339),
+		KeepLastStates:    keepLastStates,
+		TmStatus: &pb.StatusResponse_TmStatus{
+			NodeInfo: convertNodeInfo(resultStatus.NodeInfo),
+			SyncInfo: &pb.StatusResponse_TmStatus_SyncInfo{
+				LatestBlockHash:   fmt.Sprintf("%X", resultStatus.SyncInfo.LatestBlockHash),
+				LatestAppHash:     fmt.Sprintf("%X", resultStatus.SyncInfo.LatestAppHash),
+				LatestBlockHeight: resultStatus.SyncInfo.LatestBlockHeight,
+				LatestBlockTime:   resultStatus.SyncInfo.LatestBlockTime.Format(time.RFC3339),
+				CatchingUp:        resultStatus.SyncInfo.CatchingUp,
+			},
+			ValidatorInfo: &pb.StatusResponse_TmStatus_ValidatorInfo{
+				Address:     fmt.Sprintf("%X", resultStatus.ValidatorInfo.Address),
+				PubKey:      convertPubKey(resultStatus.ValidatorInfo.PubKey),
+				VotingPower: resultStatus.ValidatorInfo.VotingPower,
+			},
+		},
+	}
+}
+
+func convertNetInfo(resultNetInfo *ctypes.ResultNetInfo) *pb.NetInfoResponse {
+	peers := make([]*pb.NetInfoResponse_Peer, 0, len(resultNetInfo.Peers))
+	for _, peer := range resultNetInfo.Peers {
+		channels := make([]*pb.NetInfoResponse_Peer_ConnectionStatus_Channel, 0, len(peer.ConnectionStatus.Channels))
+		for _, channel := range peer.ConnectionStatus.Channels {
+			channels = append(channels, &pb.NetInfoResponse_Peer_ConnectionStatus_Channel{
+				ID:                int32(channel.ID),
+				SendQueueCapacity: int64(channel.SendQueueCapacity),
+				SendQueueSize:     int64(channel.SendQueueSize),
+				Priority:          int64(channel.Priority),
+				RecentlySent:      channel.RecentlySent,
+			})
+		}
+		peers = append(peers, &pb.NetInfoResponse_Peer{
+			NodeInfo:   convertNodeInfo(peer.NodeInfo),
+			IsOutbound: peer.IsOutbound,
+			ConnectionStatus: &pb.NetInfoResponse_Peer_ConnectionStatus{
+				Duration:    int64(peer.ConnectionStatus.Duration),
+				SendMonitor: convertMonitor(peer.ConnectionStatus.SendMonitor),
+				RecvMonitor: convertMonitor(peer.ConnectionStatus.RecvMonitor),
+				Channels:    channels,
+			},
+			RemoteIp: peer.RemoteIP,
+		})
+	}
+
+	return &pb.NetInfoResponse{
+		Listening: resultNetInfo.Listening,
+		Listeners: resultNetInfo.Listeners,
+		NPeers:    int64(resultNetInfo.NPeers),
+		Peers:     peers,
+	}
+}
+
+// convertNodeInfo maps the node info the same way for the node itself and its peers:
+// the id as used in peer addresses and the channels in hex, as Tendermint encodes them in JSON
+func convertNodeInfo(nodeInfo p2p.DefaultNodeInfo) *pb.NodeInfo {
+	return &pb.NodeInfo{
+		ProtocolVersion: &pb.NodeInfo_ProtocolVersion{
+			P2P:   uint64(nodeInfo.ProtocolVersion.P2P),
+			Block: uint64(nodeInfo.ProtocolVersion.Block),
+			App:   uint64(nodeInfo.ProtocolVersion.App),
+		},
+		Id:         string(nodeInfo.ID_),
+		ListenAddr: nodeInfo.ListenAddr,
+		Network:    nodeInfo.Network,
+		Version:    nodeInfo.Version,
+		Channels:   fmt.Sprintf("%X", []byte(nodeInfo.Channels)),
+		Moniker:    nodeInfo.Moniker,
+		Other: &pb.NodeInfo_Other{
+			TxIndex:    nodeInfo.Other.TxIndex,
+			RpcAddress: nodeInfo.Other.RPCAddress,
+		},
+	}
+}
+
+// convertPubKey returns the amino type of the key with its Minter form, Mp..., for ed25519 keys
+func convertPubKey(pubKey crypto.PubKey) *pb.StatusResponse_TmStatus_ValidatorInfo_PubKey {
+	if pubKey == nil {
+		return &pb.StatusResponse_TmStatus_ValidatorInfo_PubKey{}
+	}
+	keyType, _ := cryptoAmino.PubkeyAminoName(nil, pubKey)
+	value := fmt.Sprintf("%X", pubKey.Bytes())
+	if key, ok := pubKey.(ed25519.PubKeyEd25519); ok {
+		value = minterTypes.Pubkey(key).String()
+	}
+	return &pb.StatusResponse_TmStatus_ValidatorInfo_PubKey{
+		Type:  keyType,
+		Value: value,
+	}
+}
+
+func convertMonitor(monitor flowrate.Status) *pb.NetInfoResponse_Peer_ConnectionStatus_Monitor {
+	return &pb.NetInfoResponse_Peer_ConnectionStatus_Monitor{
+		Active:   monitor.Active,
+		Start:    monitor.Start.Format(time.RFC3339),
+		Duration: int64(monitor.Duration),
+		Idle:     int64(monitor.Idle),
+		Bytes:    monitor.Bytes,
+		Samples:  monitor.Samples,
+		InstRate: monitor.InstRate,
+		CurRate:  monitor.CurRate,
+		AvgRate:  monitor.AvgRate,
+		PeakRate: monitor.PeakRate,
+		BytesRem: monitor.BytesRem,
+		TimeRem:  int64(monitor.TimeRem),
+		Progress: uint32(monitor.Progress),
+	}
+}
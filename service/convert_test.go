@@ -0,0 +1,125 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/flowrate"
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/p2p/conn"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+var testTime = time.Date(2019, 11, 13, 11, 3, 40, 0, time.UTC)
+
+func testNodeInfo(id p2p.ID, moniker string) p2p.DefaultNodeInfo {
+	return p2p.DefaultNodeInfo{
+		ProtocolVersion: p2p.NewProtocolVersion(7, 10, 4),
+		ID_:             id,
+		ListenAddr:      "tcp://0.0.0.0:26656",
+		Network:         "minter-mainnet-2",
+		Version:         "0.32.6",
+		Channels:        []byte{0x40, 0x20, 0x21, 0x22, 0x23, 0x30, 0x38, 0x00},
+		Moniker:         moniker,
+		Other: p2p.DefaultNodeInfoOther{
+			TxIndex:    "on",
+			RPCAddress: "tcp://127.0.0.1:26657",
+		},
+	}
+}
+
+func TestConvertStatus(t *testing.T) {
+	var pubKey ed25519.PubKeyEd25519
+	for i := range pubKey {
+		pubKey[i] = byte(i)
+	}
+	response := convertStatus(&ctypes.ResultStatus{
+		NodeInfo: testNodeInfo("a4a8b9d2f3e0c1b2a4a8b9d2f3e0c1b2a4a8b9d2", "node"),
+		SyncInfo: ctypes.SyncInfo{
+			LatestBlockHash:   []byte{0xde, 0xad, 0xbe, 0xef},
+			LatestAppHash:     []byte{0xca, 0xfe},
+			LatestBlockHeight: 100,
+			LatestBlockTime:   testTime,
+			CatchingUp:        true,
+		},
+		ValidatorInfo: ctypes.ValidatorInfo{
+			Address:     pubKey.Address(),
+			PubKey:      pubKey,
+			VotingPower: 10,
+		},
+	}, 120)
+	response.Version = "test"
+
+	assertGolden(t, "status.golden", response)
+}
+
+func TestConvertNetInfo(t *testing.T) {
+	monitor := flowrate.Status{
+		Active:   true,
+		Start:    testTime,
+		Duration: time.Minute,
+		Idle:     time.Second,
+		Bytes:    1024,
+		Samples:  60,
+		InstRate: 10,
+		CurRate:  20,
+		AvgRate:  15,
+		PeakRate: 30,
+		Progress: 50,
+	}
+	response := convertNetInfo(&ctypes.ResultNetInfo{
+		Listening: true,
+		Listeners: []string{"Listener(@0.0.0.0:26656)"},
+		NPeers:    1,
+		Peers: []ctypes.Peer{{
+			NodeInfo:   testNodeInfo("f3e0c1b2a4a8b9d2f3e0c1b2a4a8b9d2a4a8b9d2", "peer"),
+			IsOutbound: true,
+			ConnectionStatus: p2p.ConnectionStatus{
+				Duration:    time.Hour,
+				SendMonitor: monitor,
+				RecvMonitor: monitor,
+				Channels: []conn.ChannelStatus{{
+					ID:                0x40,
+					SendQueueCapacity: 100,
+					SendQueueSize:     1,
+					Priority:          5,
+					RecentlySent:      12,
+				}},
+			},
+			RemoteIP: "10.0.0.1",
+		}},
+	})
+
+	assertGolden(t, "net_info.golden", response)
+}
+
+// assertGolden compares the indented json of the value with the golden file, go test -update rewrites it
+func assertGolden(t *testing.T, name string, value interface{}) {
+	t.Helper()
+	got, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got = append(got, '\n')
+
+	path := filepath.Join("testdata", name)
+	if *update {
+		if err := ioutil.WriteFile(path, got, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Fatalf("%s mismatch:\n%s", name, got)
+	}
+}
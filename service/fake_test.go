@@ -0,0 +1,326 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	eventsdb "github.com/MinterTeam/events-db"
+	"github.com/MinterTeam/minter-go-node/config"
+	"github.com/MinterTeam/minter-go-node/core/state"
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/p2p/pex"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmState "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/types"
+	"github.com/tendermint/tm-db"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+var errFake = errors.New("fake failure")
+
+// fakeBlockchain is an in-memory Minter application
+type fakeBlockchain struct {
+	mu     sync.Mutex
+	height uint64
+	state  *state.State
+	events eventsdb.IEventsDB
+	// pruned is shared with the fake node, pruneErr fails PruneBlocks
+	pruned   map[int64]bool
+	pruneErr error
+}
+
+func newFakeBlockchain(height uint64) *fakeBlockchain {
+	s, err := state.NewState(0, db.NewMemDB(), nil, 1, 1024)
+	if err != nil {
+		panic(err)
+	}
+	return &fakeBlockchain{height: height, state: s, events: eventsdb.NewEventsStore(db.NewMemDB()), pruned: make(map[int64]bool)}
+}
+
+func (b *fakeBlockchain) Height() uint64 {
+	return b.height
+}
+
+func (b *fakeBlockchain) CurrentState() *state.State {
+	return b.state
+}
+
+func (b *fakeBlockchain) GetEventsDB() eventsdb.IEventsDB {
+	return b.events
+}
+
+func (b *fakeBlockchain) PruneBlocks(from, to int64) error {
+	if b.pruneErr != nil {
+		return b.pruneErr
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for h := from; h <= to; h++ {
+		b.pruned[h] = true
+	}
+	return nil
+}
+
+// isPruned tells whether the block of the height is pruned
+func (b *fakeBlockchain) isPruned(height int64) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.pruned[height]
+}
+
+// fakeTendermintRPC serves fixed results, err fails every call
+type fakeTendermintRPC struct {
+	mu          sync.Mutex
+	err         error
+	status      *ctypes.ResultStatus
+	netInfo     *ctypes.ResultNetInfo
+	dialed      []string
+	blocks      map[int64]*types.Block
+	results     map[int64]*tmState.ABCIResponses
+	commits     map[int64]*types.Commit
+	validators  []*types.Validator
+	txs         map[string]*ctypes.ResultTx
+	unconfirmed []types.Tx
+	newBlocks   chan ctypes.ResultEvent
+}
+
+func newFakeTendermintRPC() *fakeTendermintRPC {
+	return &fakeTendermintRPC{
+		status:    &ctypes.ResultStatus{},
+		netInfo:   &ctypes.ResultNetInfo{},
+		blocks:    make(map[int64]*types.Block),
+		results:   make(map[int64]*tmState.ABCIResponses),
+		commits:   make(map[int64]*types.Commit),
+		txs:       make(map[string]*ctypes.ResultTx),
+		newBlocks: make(chan ctypes.ResultEvent, 1),
+	}
+}
+
+func (r *fakeTendermintRPC) Status() (*ctypes.ResultStatus, error) {
+	return r.status, r.err
+}
+
+func (r *fakeTendermintRPC) Subscribe(ctx context.Context, subscriber, query string, outCapacity ...int) (<-chan ctypes.ResultEvent, error) {
+	return r.newBlocks, r.err
+}
+
+func (r *fakeTendermintRPC) UnsubscribeAll(ctx context.Context, subscriber string) error {
+	return r.err
+}
+
+func (r *fakeTendermintRPC) NetInfo() (*ctypes.ResultNetInfo, error) {
+	return r.netInfo, r.err
+}
+
+func (r *fakeTendermintRPC) DialPeers(peers []string, persistent bool) (*ctypes.ResultDialPeers, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.dialed = append(r.dialed, peers...)
+	return &ctypes.ResultDialPeers{Log: "Dialing peers in progress"}, nil
+}
+
+func (r *fakeTendermintRPC) Block(height *int64) (*ctypes.ResultBlock, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	block, ok := r.blocks[*height]
+	if !ok {
+		return &ctypes.ResultBlock{}, nil
+	}
+	return &ctypes.ResultBlock{BlockMeta: types.NewBlockMeta(block, block.MakePartSet(types.BlockPartSizeBytes)), Block: block}, nil
+}
+
+func (r *fakeTendermintRPC) BlockResults(height *int64) (*ctypes.ResultBlockResults, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	results, ok := r.results[*height]
+	if !ok {
+		return nil, tmState.ErrNoABCIResponsesForHeight{Height: *height}
+	}
+	return &ctypes.ResultBlockResults{Height: *height, Results: results}, nil
+}
+
+func (r *fakeTendermintRPC) Commit(height *int64) (*ctypes.ResultCommit, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	commit, ok := r.commits[*height]
+	if !ok {
+		commit = &types.Commit{}
+	}
+	return ctypes.NewResultCommit(&types.Header{Height: *height}, commit, true), nil
+}
+
+func (r *fakeTendermintRPC) Validators(height *int64) (*ctypes.ResultValidators, error) {
+	return &ctypes.ResultValidators{BlockHeight: *height, Validators: r.validators}, r.err
+}
+
+func (r *fakeTendermintRPC) TxSearch(query string, prove bool, page, perPage int) (*ctypes.ResultTxSearch, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	for hash, tx := range r.txs {
+		if query == fmt.Sprintf("tx.hash='%X'", hash) {
+			return &ctypes.ResultTxSearch{Txs: []*ctypes.ResultTx{tx}, TotalCount: 1}, nil
+		}
+	}
+	return &ctypes.ResultTxSearch{Txs: []*ctypes.ResultTx{}}, nil
+}
+func (r *fakeTendermintRPC) UnconfirmedTxs(limit int) (*ctypes.ResultUnconfirmedTxs, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	txs := r.unconfirmed
+	if limit > 0 && len(txs) > limit {
+		txs = txs[:limit]
+	}
+	return &ctypes.ResultUnconfirmedTxs{Count: len(txs), Total: len(r.unconfirmed), TotalBytes: r.unconfirmedBytes(), Txs: txs}, nil
+}
+
+func (r *fakeTendermintRPC) NumUnconfirmedTxs() (*ctypes.ResultUnconfirmedTxs, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	return &ctypes.ResultUnconfirmedTxs{Count: len(r.unconfirmed), Total: len(r.unconfirmed), TotalBytes: r.unconfirmedBytes()}, nil
+}
+
+func (r *fakeTendermintRPC) unconfirmedBytes() int64 {
+	var size int64
+	for _, tx := range r.unconfirmed {
+		size += int64(len(tx))
+	}
+	return size
+}
+
+// fakeNode keeps the connected peers in memory
+type fakeNode struct {
+	mu      sync.Mutex
+	peers   map[p2p.ID]p2p.Peer
+	stopped []p2p.ID
+	flushed int
+	height  int64
+	book    AddressBook
+	// pruned tells whether the block of a height is pruned
+	pruned func(height int64) bool
+}
+
+func newFakeNode(height int64, peers ...p2p.ID) *fakeNode {
+	node := &fakeNode{peers: make(map[p2p.ID]p2p.Peer), height: height}
+	for _, id := range peers {
+		node.peers[id] = fakePeer{id: id}
+	}
+	return node
+}
+
+func (n *fakeNode) Peers() p2p.IPeerSet {
+	return fakePeerSet{node: n}
+}
+
+func (n *fakeNode) StopPeerGracefully(peer p2p.Peer) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	delete(n.peers, peer.ID())
+	n.stopped = append(n.stopped, peer.ID())
+}
+
+func (n *fakeNode) FlushMempool() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.flushed++
+}
+
+func (n *fakeNode) BlockStoreHeight() int64 {
+	return n.height
+}
+
+func (n *fakeNode) BlockStored(height int64) bool {
+	return height >= 1 && height <= n.height && (n.pruned == nil || !n.pruned(height))
+}
+
+func (n *fakeNode) AddressBook() AddressBook {
+	return n.book
+}
+
+// newFakeAddressBook returns a PEX address book in a temporary directory, set as the address book file of the config,
+// removed by the returned function
+func newFakeAddressBook(cfg *config.Config, addresses ...string) (AddressBook, func(), error) {
+	dir, err := ioutil.TempDir("", "addrbook")
+	if err != nil {
+		return nil, nil, err
+	}
+	cfg.P2P.AddrBook = filepath.Join(dir, "addrbook.json")
+	var book AddressBook = pex.NewAddrBook(cfg.P2P.AddrBookFile(), false)
+	for _, address := range addresses {
+		addr, err := p2p.NewNetAddressString(address)
+		if err == nil {
+			err = book.AddAddress(addr, addr)
+		}
+		if err != nil {
+			_ = os.RemoveAll(dir)
+			return nil, nil, err
+		}
+	}
+	return book, func() { _ = os.RemoveAll(dir) }, nil
+}
+
+type fakePeerSet struct {
+	node *fakeNode
+}
+
+func (s fakePeerSet) Has(key p2p.ID) bool {
+	return s.Get(key) != nil
+}
+
+func (s fakePeerSet) HasIP(ip net.IP) bool {
+	return false
+}
+
+func (s fakePeerSet) Get(key p2p.ID) p2p.Peer {
+	s.node.mu.Lock()
+	defer s.node.mu.Unlock()
+
+	return s.node.peers[key]
+}
+
+func (s fakePeerSet) List() []p2p.Peer {
+	s.node.mu.Lock()
+	defer s.node.mu.Unlock()
+
+	peers := make([]p2p.Peer, 0, len(s.node.peers))
+	for _, peer := range s.node.peers {
+		peers = append(peers, peer)
+	}
+	return peers
+}
+
+func (s fakePeerSet) Size() int {
+	return len(s.List())
+}
+
+// fakePeer only knows its id and whether it is persistent, the rest of p2p.Peer is left unimplemented
+type fakePeer struct {
+	p2p.Peer
+	id         p2p.ID
+	persistent bool
+}
+
+func (p fakePeer) ID() p2p.ID {
+	return p.id
+}
+
+func (p fakePeer) IsPersistent() bool {
+	return p.persistent
+}
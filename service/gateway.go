@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/MinterTeam/minter-node-cli/pb"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/test/bufconn"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+)
+
+const (
+	gatewayBufferSize = 1 << 20
+	// gatewayCallerKey is the metadata carrying the peer credentials of the HTTP caller to the gateway server
+	gatewayCallerKey = "x-manager-caller"
+	unixScheme       = "unix://"
+)
+
+type gatewayCredentialsKey struct{}
+
+// anonymousCaller is the AuthInfo of gateway callers whose credentials are not available
+type anonymousCaller struct{}
+
+func (anonymousCaller) AuthType() string {
+	return "anonymous"
+}
+
+// listenGateway listens on the unix socket of a unix:// address, replacing a stale socket, or on the TCP address,
+// which StartCLIServer only accepts on loopback
+func listenGateway(address string) (net.Listener, error) {
+	if !strings.HasPrefix(address, unixScheme) {
+		return net.Listen("tcp", address)
+	}
+	socketPath := strings.TrimPrefix(address, unixScheme)
+	if err := os.RemoveAll(socketPath); err != nil {
+		return nil, err
+	}
+	return net.Listen("unix", socketPath)
+}
+
+// isLoopbackAddress tells whether the host:port address is on localhost or a loopback IP
+func isLoopbackAddress(address string) bool {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// newGateway returns the HTTP server translating requests into calls to the manager served on the listener
+func newGateway(ctx context.Context, lis *bufconn.Listener) (*http.Server, *grpc.ClientConn, error) {
+	conn, err := grpc.DialContext(ctx, "bufconn", grpc.WithInsecure(), grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+		return lis.Dial()
+	}))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{OrigName: true, EmitDefaults: true}),
+		// no header is forwarded, so that callers cannot set the gateway caller metadata
+		runtime.WithIncomingHeaderMatcher(func(string) (string, bool) { return "", false }),
+		runtime.WithMetadata(gatewayCallerMetadata),
+	)
+	if err := pb.RegisterManagerServiceHandler(ctx, mux, conn); err != nil {
+		_ = conn.Close()
+		return nil, nil, err
+	}
+
+	server := &http.Server{
+		Handler: mux,
+		ConnContext: func(ctx context.Context, c net.Conn) context.Context {
+			if creds, err := readPeerCredentials(c); err == nil {
+				return context.WithValue(ctx, gatewayCredentialsKey{}, creds)
+			}
+			return ctx
+		},
+	}
+	return server, conn, nil
+}
+
+func gatewayCallerMetadata(_ context.Context, r *http.Request) metadata.MD {
+	creds, ok := r.Context().Value(gatewayCredentialsKey{}).(*PeerCredentials)
+	if !ok {
+		return nil
+	}
+	return metadata.Pairs(gatewayCallerKey, fmt.Sprintf("%d:%d:%d", creds.Pid, creds.Uid, creds.Gid))
+}
+
+// gatewayCallerContext replaces the peer of the gateway connection by the HTTP caller
+func gatewayCallerContext(ctx context.Context) context.Context {
+	pr, ok := peer.FromContext(ctx)
+	if !ok {
+		pr = &peer.Peer{}
+	}
+	caller := &peer.Peer{Addr: pr.Addr, AuthInfo: anonymousCaller{}}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(gatewayCallerKey); len(values) == 1 {
+		creds := &PeerCredentials{}
+		if _, err := fmt.Sscanf(values[0], "%d:%d:%d", &creds.Pid, &creds.Uid, &creds.Gid); err == nil {
+			caller.AuthInfo = creds
+		}
+	}
+	return peer.NewContext(ctx, caller)
+}
+
+func gatewayUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	return handler(gatewayCallerContext(ctx), req)
+}
+
+func gatewayStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &gatewayServerStream{ServerStream: ss, ctx: gatewayCallerContext(ss.Context())})
+}
+
+type gatewayServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *gatewayServerStream) Context() context.Context {
+	return s.ctx
+}
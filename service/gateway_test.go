@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/MinterTeam/minter-node-cli/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGateway(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("peer credentials are only supported on linux")
+	}
+
+	dir, err := ioutil.TempDir("", "gateway")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "policy.json")
+	if err := ioutil.WriteFile(path, []byte(`{"users": {"`+strconv.Itoa(os.Getuid())+`": "operator"}}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	policy, err := LoadPolicy(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	policy.ownerID = -1
+
+	b := newTestBackend(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	gatewayPath := filepath.Join(dir, "gateway.sock")
+	go func() {
+		_ = StartCLIServer(filepath.Join(dir, "manager.sock"), NewManagerWith(b.blockchain, b.rpc, b.node, b.cfg), ctx,
+			WithPolicy(policy), WithGatewayListener("unix://"+gatewayPath))
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	client := &http.Client{Transport: &http.Transport{DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+		return (&net.Dialer{}).DialContext(ctx, "unix", gatewayPath)
+	}}}
+
+	for _, tt := range []struct {
+		method, path, body string
+		code               int
+	}{
+		{"GET", "/status", "", http.StatusOK},
+		{"GET", "/net_info", "", http.StatusOK},
+		{"POST", "/peers/dial", `{"address": "` + string(testPeerID) + `@10.0.0.2:26656"}`, http.StatusOK},
+		{"GET", "/tx/Mt00", "", http.StatusBadRequest},
+		// operators may not prune
+		{"POST", "/prune", `{"from_height": 95, "to_height": 96}`, http.StatusForbidden},
+	} {
+		req, err := http.NewRequest(tt.method, "http://gateway"+tt.path, strings.NewReader(tt.body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := ioutil.ReadAll(res.Body)
+		_ = res.Body.Close()
+		if res.StatusCode != tt.code {
+			t.Errorf("%s %s = %d %s, want %d", tt.method, tt.path, res.StatusCode, body, tt.code)
+		}
+		if tt.path == "/status" {
+			var response struct {
+				LatestBlockHeight string `json:"latest_block_height"`
+			}
+			if err := json.Unmarshal(body, &response); err != nil || response.LatestBlockHeight != "100" {
+				t.Errorf("unexpected status: %s", body)
+			}
+		}
+	}
+	if len(b.rpc.dialed) != 1 {
+		t.Errorf("dialed %v", b.rpc.dialed)
+	}
+}
+
+func TestGatewayCaller(t *testing.T) {
+	policy := &Policy{users: map[uint32]Role{1001: RoleOperator}, def: RoleReadOnly, ownerID: -1}
+
+	// callers without credentials, e.g. over TCP, get the default role
+	ctx := gatewayCallerContext(context.Background())
+	if err := policy.authorize(ctx, "/pb.ManagerService/Status"); err != nil {
+		t.Errorf("Status: %v", err)
+	}
+	if err := policy.authorize(ctx, "/pb.ManagerService/DealPeer"); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("DealPeer: %v", err)
+	}
+
+	ctx = gatewayCallerContext(metadata.NewIncomingContext(context.Background(), metadata.Pairs(gatewayCallerKey, "1:1001:1001")))
+	if err := policy.authorize(ctx, "/pb.ManagerService/DealPeer"); err != nil {
+		t.Errorf("DealPeer: %v", err)
+	}
+}
+
+func TestGatewayOptions(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gateway")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	policy := &Policy{def: RoleReadOnly, ownerID: -1}
+	for _, tt := range []struct {
+		name string
+		opts []ServerOption
+	}{
+		{"all interfaces", []ServerOption{WithPolicy(policy), WithGatewayListener(":8843")}},
+		{"public address", []ServerOption{WithPolicy(policy), WithGatewayListener("10.0.0.1:8843")}},
+		{"no port", []ServerOption{WithPolicy(policy), WithGatewayListener("127.0.0.1")}},
+		{"no policy", []ServerOption{WithGatewayListener("127.0.0.1:8843")}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := StartCLIServer(filepath.Join(dir, "manager.sock"), &pb.UnimplementedManagerServiceServer{}, context.Background(), tt.opts...)
+			if err == nil {
+				t.Error("StartCLIServer() accepted the gateway")
+			}
+		})
+	}
+
+	for _, address := range []string{"127.0.0.1:8843", "[::1]:8843", "localhost:8843"} {
+		if !isLoopbackAddress(address) {
+			t.Errorf("isLoopbackAddress(%q) = false", address)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/MinterTeam/minter-node-cli/pb"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"path/filepath"
+	"time"
+)
+
+// HealthRules are the conditions for a node to be ready besides being synced, zero disables a rule
+type HealthRules struct {
+	// MinPeers is the minimum number of connected peers
+	MinPeers int64
+	// MaxBlockAge is the maximum time since the latest block
+	MaxBlockAge time.Duration
+	// MinFreeDisk is the minimum percentage of free space on the volume of the data directory
+	MinFreeDisk float64
+}
+
+// DefaultHealthRules are checked by the grpc.health.v1 service unless changed with WithHealthRules
+var DefaultHealthRules = HealthRules{MinPeers: 1, MaxBlockAge: time.Minute, MinFreeDisk: defaultMinFreeDisk}
+
+func (r HealthRules) request() *pb.HealthRequest {
+	return &pb.HealthRequest{MinPeers: r.MinPeers, MaxBlockAge: int64(r.MaxBlockAge), MinFreeDisk: r.MinFreeDisk}
+}
+
+// Health checks that the node is not catching up and the rules of the request, zero rules are not checked
+func (m *Manager) Health(ctx context.Context, req *pb.HealthRequest) (*pb.HealthResponse, error) {
+	if req.MinPeers < 0 || req.MaxBlockAge < 0 || req.MinFreeDisk < 0 || req.MinFreeDisk > 100 {
+		return new(pb.HealthResponse), status.Errorf(codes.InvalidArgument, "invalid rules: %v", req)
+	}
+
+	resultStatus, err := m.tmRPC.Status()
+	if err != nil {
+		return new(pb.HealthResponse), status.Error(codes.Internal, err.Error())
+	}
+
+	response := &pb.HealthResponse{Healthy: true}
+	check := func(name string, healthy bool, format string, a ...interface{}) {
+		response.Checks = append(response.Checks, &pb.HealthResponse_Check{Name: name, Healthy: healthy, Message: fmt.Sprintf(format, a...)})
+		response.Healthy = response.Healthy && healthy
+	}
+
+	if resultStatus.SyncInfo.CatchingUp {
+		check("catching_up", false, "catching up at height %d", resultStatus.SyncInfo.LatestBlockHeight)
+	} else {
+		check("catching_up", true, "synced at height %d", resultStatus.SyncInfo.LatestBlockHeight)
+	}
+
+	if req.MinPeers > 0 {
+		netInfo, err := m.tmRPC.NetInfo()
+		if err != nil {
+			return new(pb.HealthResponse), status.Error(codes.Internal, err.Error())
+		}
+		check("peers", int64(netInfo.NPeers) >= req.MinPeers, "%d connected, at least %d required", netInfo.NPeers, req.MinPeers)
+	}
+
+	if req.MaxBlockAge > 0 {
+		age := time.Since(resultStatus.SyncInfo.LatestBlockTime).Round(time.Second)
+		check("block_age", age <= time.Duration(req.MaxBlockAge), "latest block %s ago, at most %s allowed", age, time.Duration(req.MaxBlockAge))
+	}
+
+	if req.MinFreeDisk > 0 {
+		free, total, err := diskUsage(filepath.Join(m.cfg.RootDir, "data"))
+		healthy, message := freeDiskCheck(free, total, err, req.MinFreeDisk)
+		check("free_disk", healthy, "%s", message)
+	}
+
+	return response, nil
+}
+
+// freeDiskCheck compares the free space of the volume to the minimum percentage.
+// A free space which cannot be measured, either as diskUsage fails or as the volume reports no size,
+// is unknown and passes, for the nodes on platforms or file systems without disk usage to be ready.
+func freeDiskCheck(free, total int64, err error, minFree float64) (bool, string) {
+	if err == nil && total <= 0 {
+		err = fmt.Errorf("the volume reports a size of %d bytes", total)
+	}
+	if err != nil {
+		return true, fmt.Sprintf("unknown: %s", err)
+	}
+	percent := float64(free) * 100 / float64(total)
+	return percent >= minFree, fmt.Sprintf("%s (%.1f%%) free, at least %.1f%% required", formatBytes(free), percent, minFree)
+}
+
+// healthWatchInterval is how often the health is checked for the Watch streams
+const healthWatchInterval = 5 * time.Second
+
+// healthServer is the standard grpc.health.v1 service of the manager,
+// serving while the manager Health method reports the node healthy under the rules
+type healthServer struct {
+	manager pb.ManagerServiceServer
+	rules   HealthRules
+}
+
+func (s healthServer) servingStatus(ctx context.Context, service string) (healthpb.HealthCheckResponse_ServingStatus, error) {
+	if service != "" && service != "pb.ManagerService" {
+		return healthpb.HealthCheckResponse_SERVICE_UNKNOWN, status.Errorf(codes.NotFound, "unknown service %q", service)
+	}
+	response, err := s.manager.Health(ctx, s.rules.request())
+	if err != nil || !response.Healthy {
+		return healthpb.HealthCheckResponse_NOT_SERVING, nil
+	}
+	return healthpb.HealthCheckResponse_SERVING, nil
+}
+
+func (s healthServer) Check(ctx context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	servingStatus, err := s.servingStatus(ctx, req.Service)
+	if err != nil {
+		return nil, err
+	}
+	return &healthpb.HealthCheckResponse{Status: servingStatus}, nil
+}
+
+// Watch sends the serving status and then every change of it, unknown services are reported as such
+func (s healthServer) Watch(req *healthpb.HealthCheckRequest, stream healthpb.Health_WatchServer) error {
+	ticker := time.NewTicker(healthWatchInterval)
+	defer ticker.Stop()
+
+	last := healthpb.HealthCheckResponse_ServingStatus(-1)
+	for {
+		servingStatus, _ := s.servingStatus(stream.Context(), req.Service)
+		if servingStatus != last {
+			if err := stream.Send(&healthpb.HealthCheckResponse{Status: servingStatus}); err != nil {
+				return err
+			}
+			last = servingStatus
+		}
+
+		select {
+		case <-ticker.C:
+		case <-stream.Context().Done():
+			return status.FromContextError(stream.Context().Err()).Err()
+		}
+	}
+}
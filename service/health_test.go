@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHealthServer(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		service string
+		setup   func(b *testBackend, rules *HealthRules)
+		want    healthpb.HealthCheckResponse_ServingStatus
+	}{
+		{name: "serving", want: healthpb.HealthCheckResponse_SERVING},
+		{name: "manager service", service: "pb.ManagerService", want: healthpb.HealthCheckResponse_SERVING},
+		{
+			name:  "catching up",
+			setup: func(b *testBackend, rules *HealthRules) { b.rpc.status.SyncInfo.CatchingUp = true },
+			want:  healthpb.HealthCheckResponse_NOT_SERVING,
+		},
+		{
+			name:  "few peers",
+			setup: func(b *testBackend, rules *HealthRules) { rules.MinPeers = 2 },
+			want:  healthpb.HealthCheckResponse_NOT_SERVING,
+		},
+		{
+			name:  "stale block",
+			setup: func(b *testBackend, rules *HealthRules) { rules.MaxBlockAge = time.Minute },
+			want:  healthpb.HealthCheckResponse_NOT_SERVING,
+		},
+		{
+			name:  "unreachable node",
+			setup: func(b *testBackend, rules *HealthRules) { b.rpc.err = errFake },
+			want:  healthpb.HealthCheckResponse_NOT_SERVING,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newTestBackend(t)
+			rules := HealthRules{MinPeers: 1}
+			if tt.setup != nil {
+				tt.setup(b, &rules)
+			}
+			server := healthServer{manager: NewManagerWith(b.blockchain, b.rpc, b.node, b.cfg), rules: rules}
+			response, err := server.Check(context.Background(), &healthpb.HealthCheckRequest{Service: tt.service})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if response.Status != tt.want {
+				t.Errorf("Check() = %s, want %s", response.Status, tt.want)
+			}
+		})
+	}
+
+	b := newTestBackend(t)
+	server := healthServer{manager: NewManagerWith(b.blockchain, b.rpc, b.node, b.cfg)}
+	_, err := server.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "unknown"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Check() error = %v, want NotFound", err)
+	}
+}
+
+func TestHealthCommand(t *testing.T) {
+	dir, err := ioutil.TempDir("", "health")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	b := newTestBackend(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	socketPath := filepath.Join(dir, "manager.sock")
+	go func() {
+		_ = StartCLIServer(socketPath, NewManagerWith(b.blockchain, b.rpc, b.node, b.cfg), ctx)
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	console, err := ConfigureManagerConsole(socketPath, WithHistory("", 0, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := console.Execute([]string{"health", "--max-block-age", "0", "--min-free", "0"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := console.Execute([]string{"health", "--output", "table"}); err == nil || !strings.Contains(err.Error(), "block_age") {
+		t.Fatalf("health of a stale node: %v", err)
+	}
+}
+
+func TestFreeDiskCheck(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		free, total int64
+		err         error
+		healthy     bool
+		message     string
+	}{
+		{name: "enough", free: 50, total: 100, healthy: true, message: "50 B (50.0%) free"},
+		{name: "low", free: 5, total: 100, healthy: false, message: "5 B (5.0%) free"},
+		{name: "unsupported", err: errors.New("not supported"), healthy: true, message: "unknown: not supported"},
+		{name: "no size", healthy: true, message: "unknown: the volume reports a size of 0 bytes"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			healthy, message := freeDiskCheck(tt.free, tt.total, tt.err, 10)
+			if healthy != tt.healthy || !strings.HasPrefix(message, tt.message) {
+				t.Errorf("freeDiskCheck() = %v, %q", healthy, message)
+			}
+		})
+	}
+}
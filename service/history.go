@@ -0,0 +1,229 @@
+package service
+
+import (
+	"bufio"
+	"github.com/c-bata/go-prompt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+const DefaultHistoryLimit = 1000
+
+// DefaultSecretPatterns match the console lines never written to the history
+var DefaultSecretPatterns = []string{`(?i)(password|passphrase|secret|token|mnemonic|seed_phrase|private[_-]?key)`}
+
+// History is the console history persisted to a file, without duplicates and lines containing secrets
+type History struct {
+	path    string
+	limit   int
+	secrets []*regexp.Regexp
+	lines   []string
+}
+
+// DefaultHistoryPath returns the per-user history file, or an empty path if the home directory is unknown
+func DefaultHistoryPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".minter_manager_history")
+}
+
+// LoadHistory reads the history file, an empty path keeps the history in memory only
+func LoadHistory(path string, limit int, secretPatterns []string) (*History, error) {
+	h := &History{path: path, limit: limit}
+	for _, pattern := range secretPatterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, err
+		}
+		h.secrets = append(h.secrets, re)
+	}
+
+	if path == "" {
+		return h, nil
+	}
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return h, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		h.add(scanner.Text())
+	}
+	return h, scanner.Err()
+}
+
+// Lines returns the history from the oldest line
+func (h *History) Lines() []string {
+	return append([]string(nil), h.lines...)
+}
+
+// Get returns the line by its 1-based number, as listed by the history command
+func (h *History) Get(n int) (string, bool) {
+	if n < 1 || n > len(h.lines) {
+		return "", false
+	}
+	return h.lines[n-1], true
+}
+
+// Search returns the latest line before the index containing the query
+func (h *History) Search(query string, before int) (int, string, bool) {
+	if before > len(h.lines) {
+		before = len(h.lines)
+	}
+	for i := before - 1; i >= 0; i-- {
+		if strings.Contains(h.lines[i], query) {
+			return i, h.lines[i], true
+		}
+	}
+	return 0, "", false
+}
+
+// Add appends the line and saves the history file
+func (h *History) Add(line string) error {
+	if !h.add(line) || h.path == "" {
+		return nil
+	}
+	data := strings.Join(h.lines, "\n") + "\n"
+	tmp := h.path + ".tmp"
+	if err := ioutil.WriteFile(tmp, []byte(data), 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, h.path)
+}
+
+func (h *History) add(line string) bool {
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return false
+	}
+	for _, secret := range h.secrets {
+		if secret.MatchString(line) {
+			return false
+		}
+	}
+	for i, existing := range h.lines {
+		if existing == line {
+			h.lines = append(h.lines[:i], h.lines[i+1:]...)
+			break
+		}
+	}
+	h.lines = append(h.lines, line)
+	if h.limit > 0 && len(h.lines) > h.limit {
+		h.lines = h.lines[len(h.lines)-h.limit:]
+	}
+	return true
+}
+
+// historySearch is the reverse incremental search of the console bound to Ctrl-R, in the manner of bash:
+// while searching, the typed characters extend a query shown in the prompt instead of the input,
+// which is replaced by the latest history line containing the query. Ctrl-R again finds older lines,
+// Backspace shortens the query, Escape, Ctrl-G or the arrows keep the found line and stop the search.
+type historySearch struct {
+	history *History
+	active  bool
+	query   string
+	// index is the history index of the match, the next search looks before it
+	index   int
+	match   string
+	failing bool
+}
+
+// keyBinds binds the search to Ctrl-R and the keys editing its query
+func (s *historySearch) keyBinds() ([]prompt.KeyBind, []prompt.ASCIICodeBind) {
+	keys := []prompt.KeyBind{
+		{Key: prompt.ControlR, Fn: s.next},
+		{Key: prompt.Backspace, Fn: s.backspace},
+	}
+	for _, key := range []prompt.Key{prompt.Escape, prompt.ControlG, prompt.Left, prompt.Right, prompt.Up, prompt.Down} {
+		keys = append(keys, prompt.KeyBind{Key: key, Fn: s.stop})
+	}
+
+	var codes []prompt.ASCIICodeBind
+	for c := byte(' '); c <= '~'; c++ {
+		text := string(c)
+		codes = append(codes, prompt.ASCIICodeBind{ASCIICode: []byte(text), Fn: func(buf *prompt.Buffer) {
+			s.input(buf, text)
+		}})
+	}
+	return keys, codes
+}
+
+// next starts the search, or finds an older line containing the query
+func (s *historySearch) next(buf *prompt.Buffer) {
+	if !s.active {
+		s.active, s.query, s.index, s.match, s.failing = true, "", len(s.history.lines), buf.Text(), false
+		return
+	}
+	if s.query != "" {
+		s.search(buf, s.index)
+	}
+}
+
+// input extends the query with the text, the current match is kept while it still contains it.
+// Outside of the search the text is inserted into the input as usual.
+func (s *historySearch) input(buf *prompt.Buffer, text string) {
+	if !s.active {
+		buf.InsertText(text, false, true)
+		return
+	}
+	s.query += text
+	s.search(buf, s.index+1)
+}
+
+// backspace shortens the query and searches it again from the latest line
+func (s *historySearch) backspace(buf *prompt.Buffer) {
+	if !s.active {
+		return
+	}
+	// the default binding has already deleted a character of the input, which shows the match
+	if runes := []rune(s.query); len(runes) > 0 {
+		s.query = string(runes[:len(runes)-1])
+	}
+	s.failing = false
+	if s.query == "" {
+		s.index = len(s.history.lines)
+		s.replace(buf, s.match)
+		return
+	}
+	s.search(buf, len(s.history.lines))
+}
+
+func (s *historySearch) stop(*prompt.Buffer) {
+	s.active = false
+}
+
+// search replaces the input with the latest line before the index containing the query
+func (s *historySearch) search(buf *prompt.Buffer, before int) {
+	index, line, ok := s.history.Search(s.query, before)
+	s.failing = !ok
+	if ok {
+		s.index, s.match = index, line
+	}
+	s.replace(buf, s.match)
+}
+
+func (s *historySearch) replace(buf *prompt.Buffer, text string) {
+	buf.CursorRight(len([]rune(buf.Text())))
+	buf.DeleteBeforeCursor(len([]rune(buf.Text())))
+	buf.InsertText(text, false, true)
+}
+
+func (s *historySearch) prefix() (string, bool) {
+	if !s.active {
+		return "", false
+	}
+	if s.failing {
+		return "(failing reverse-i-search `" + s.query + "') ", true
+	}
+	return "(reverse-i-search `" + s.query + "') ", true
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"github.com/c-bata/go-prompt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestHistory(t *testing.T) {
+	dir, err := ioutil.TempDir("", "history")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "history")
+	history, err := LoadHistory(path, 3, DefaultSecretPatterns)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{"status", "net_info -o table", "", "status", "dial_peer --address=id@ip:26656", "set_key --private_key=00ff", "ban_peer --id=ab --duration=1h"} {
+		if err := history.Add(line); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want := []string{"status", "dial_peer --address=id@ip:26656", "ban_peer --id=ab --duration=1h"}
+	if lines := history.Lines(); !reflect.DeepEqual(lines, want) {
+		t.Errorf("Lines() = %q, want %q", lines, want)
+	}
+
+	reloaded, err := LoadHistory(path, 3, DefaultSecretPatterns)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lines := reloaded.Lines(); !reflect.DeepEqual(lines, want) {
+		t.Errorf("reloaded Lines() = %q, want %q", lines, want)
+	}
+
+	if line, ok := reloaded.Get(2); !ok || line != want[1] {
+		t.Errorf("Get(2) = %q, %v", line, ok)
+	}
+	if index, line, ok := reloaded.Search("_peer", 3); !ok || index != 2 || line != want[2] {
+		t.Errorf("Search() = %d, %q, %v", index, line, ok)
+	}
+	if index, line, ok := reloaded.Search("_peer", 2); !ok || index != 1 || line != want[1] {
+		t.Errorf("Search() = %d, %q, %v", index, line, ok)
+	}
+	if _, _, ok := reloaded.Search("_peer", 1); ok {
+		t.Error("Search() should not find older matches")
+	}
+}
+
+func TestHistorySearch(t *testing.T) {
+	history, err := LoadHistory("", 0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{"dial_peer --address=a", "status", "ban_peer --id=b", "net_info"} {
+		if err := history.Add(line); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	search := &historySearch{history: history}
+	buf := prompt.NewBuffer()
+	buf.InsertText("sta", false, true)
+	check := func(step, input, prefix string) {
+		t.Helper()
+		if buf.Text() != input {
+			t.Errorf("%s: input = %q, want %q", step, buf.Text(), input)
+		}
+		if got, _ := search.prefix(); got != prefix {
+			t.Errorf("%s: prefix = %q, want %q", step, got, prefix)
+		}
+	}
+
+	search.next(buf)
+	check("Ctrl-R", "sta", "(reverse-i-search `') ")
+	search.input(buf, "a")
+	check("a", "ban_peer --id=b", "(reverse-i-search `a') ")
+	search.input(buf, "d")
+	check("ad", "dial_peer --address=a", "(reverse-i-search `ad') ")
+	search.next(buf)
+	check("Ctrl-R", "dial_peer --address=a", "(failing reverse-i-search `ad') ")
+	search.input(buf, "z")
+	check("adz", "dial_peer --address=a", "(failing reverse-i-search `adz') ")
+
+	// the default binding deletes a character of the input before the search handles Backspace
+	buf.DeleteBeforeCursor(1)
+	search.backspace(buf)
+	check("Backspace", "dial_peer --address=a", "(reverse-i-search `ad') ")
+	buf.DeleteBeforeCursor(1)
+	search.backspace(buf)
+	check("Backspace", "ban_peer --id=b", "(reverse-i-search `a') ")
+	search.next(buf)
+	check("Ctrl-R", "status", "(reverse-i-search `a') ")
+
+	search.stop(buf)
+	if _, ok := search.prefix(); ok {
+		t.Error("the search is still active")
+	}
+	search.input(buf, "!")
+	if buf.Text() != "status!" {
+		t.Errorf("input outside of the search = %q", buf.Text())
+	}
+}
@@ -0,0 +1,251 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/MinterTeam/minter-node-cli/pb"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/tendermint/tendermint/libs/cli/flags"
+	"github.com/tendermint/tendermint/libs/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const defaultLogModule = "*"
+
+type logLevelOverride struct {
+	level string
+	until time.Time
+}
+
+// LogLevels is a logger filtering by module with levels changed at runtime,
+// the node should log through it instead of a logger filtered by flags.ParseLogLevel.
+// Loggers derived with With follow later changes as well.
+type LogLevels struct {
+	base log.Logger
+
+	mu        sync.RWMutex
+	levels    map[string]string
+	overrides map[string]logLevelOverride
+	filtered  log.Logger
+	// generation changes with filtered, for the derived loggers to know when to derive again
+	generation uint64
+}
+
+// NewLogLevels filters the unfiltered base logger by the levels of the log_level config option,
+// e.g. "consensus:info,*:error"
+func NewLogLevels(base log.Logger, level string) (*LogLevels, error) {
+	levels, err := parseLogLevels(level)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := levels[defaultLogModule]; !ok {
+		levels[defaultLogModule] = "info"
+	}
+	l := &LogLevels{base: base, levels: levels, overrides: make(map[string]logLevelOverride)}
+	if err := l.rebuild(time.Now()); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// parseLogLevels parses module:level pairs, a single level applies to all modules
+func parseLogLevels(level string) (map[string]string, error) {
+	if level == "" {
+		return nil, fmt.Errorf("empty log level")
+	}
+	if !strings.Contains(level, ":") {
+		level = defaultLogModule + ":" + level
+	}
+	levels := make(map[string]string)
+	for _, item := range strings.Split(level, ",") {
+		moduleAndLevel := strings.Split(strings.TrimSpace(item), ":")
+		if len(moduleAndLevel) != 2 || moduleAndLevel[0] == "" {
+			return nil, fmt.Errorf("expected module:level, given %q", item)
+		}
+		if _, err := log.AllowLevel(moduleAndLevel[1]); err != nil {
+			return nil, err
+		}
+		levels[moduleAndLevel[0]] = moduleAndLevel[1]
+	}
+	return levels, nil
+}
+
+// Set changes the levels of the given modules, keeping the others.
+// With a positive ttl the modules revert to their previous levels once it passes.
+func (l *LogLevels) Set(level string, ttl time.Duration) error {
+	levels, err := parseLogLevels(level)
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	for module, level := range levels {
+		if ttl > 0 {
+			l.overrides[module] = logLevelOverride{level: level, until: now.Add(ttl)}
+			continue
+		}
+		delete(l.overrides, module)
+		l.levels[module] = level
+	}
+	if ttl > 0 {
+		time.AfterFunc(ttl, func() {
+			l.mu.Lock()
+			defer l.mu.Unlock()
+			_ = l.rebuild(time.Now())
+		})
+	}
+	return l.rebuild(now)
+}
+
+// rebuild drops the expired overrides and filters the base logger by the effective levels
+func (l *LogLevels) rebuild(now time.Time) error {
+	for module, override := range l.overrides {
+		if !now.Before(override.until) {
+			delete(l.overrides, module)
+		}
+	}
+	filtered, err := flags.ParseLogLevel(l.effective(), l.base, "info")
+	if err != nil {
+		return err
+	}
+	l.filtered = filtered
+	l.generation++
+	return nil
+}
+
+// effective returns the levels with their overrides, the default module last
+func (l *LogLevels) effective() string {
+	levels := make(map[string]string, len(l.levels)+len(l.overrides))
+	for module, level := range l.levels {
+		levels[module] = level
+	}
+	for module, override := range l.overrides {
+		levels[module] = override.level
+	}
+	modules := make([]string, 0, len(levels))
+	for module := range levels {
+		if module != defaultLogModule {
+			modules = append(modules, module)
+		}
+	}
+	sort.Strings(modules)
+	pairs := make([]string, 0, len(levels))
+	for _, module := range modules {
+		pairs = append(pairs, module+":"+levels[module])
+	}
+	return strings.Join(append(pairs, defaultLogModule+":"+levels[defaultLogModule]), ",")
+}
+
+// Levels returns the effective level of every configured module and until when the temporary ones last
+func (l *LogLevels) Levels() *pb.LogLevelResponse {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	response := &pb.LogLevelResponse{Level: l.effective()}
+	for _, pair := range strings.Split(response.Level, ",") {
+		moduleAndLevel := strings.SplitN(pair, ":", 2)
+		module := &pb.LogLevelResponse_Module{Module: moduleAndLevel[0], Level: moduleAndLevel[1]}
+		if override, ok := l.overrides[module.Module]; ok {
+			module.Until = override.until.Format(time.RFC3339)
+		}
+		response.Modules = append(response.Modules, module)
+	}
+	return response
+}
+
+func (l *LogLevels) logger() log.Logger {
+	filtered, _ := l.current()
+	return filtered
+}
+
+// current returns the filtered logger with its generation
+func (l *LogLevels) current() (log.Logger, uint64) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return l.filtered, l.generation
+}
+
+func (l *LogLevels) Debug(msg string, keyvals ...interface{}) {
+	l.logger().Debug(msg, keyvals...)
+}
+
+func (l *LogLevels) Info(msg string, keyvals ...interface{}) {
+	l.logger().Info(msg, keyvals...)
+}
+
+func (l *LogLevels) Error(msg string, keyvals ...interface{}) {
+	l.logger().Error(msg, keyvals...)
+}
+
+func (l *LogLevels) With(keyvals ...interface{}) log.Logger {
+	return &moduleLogger{levels: l, keyvals: keyvals}
+}
+
+// moduleLogger applies its context to the current filter, as the module of a filtered logger is resolved once by With.
+// The derived logger is kept until the levels change.
+type moduleLogger struct {
+	levels  *LogLevels
+	keyvals []interface{}
+
+	mu         sync.Mutex
+	generation uint64
+	derived    log.Logger
+}
+
+func (l *moduleLogger) logger() log.Logger {
+	filtered, generation := l.levels.current()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.derived == nil || l.generation != generation {
+		l.derived = filtered.With(l.keyvals...)
+		l.generation = generation
+	}
+	return l.derived
+}
+
+func (l *moduleLogger) Debug(msg string, keyvals ...interface{}) {
+	l.logger().Debug(msg, keyvals...)
+}
+
+func (l *moduleLogger) Info(msg string, keyvals ...interface{}) {
+	l.logger().Info(msg, keyvals...)
+}
+
+func (l *moduleLogger) Error(msg string, keyvals ...interface{}) {
+	l.logger().Error(msg, keyvals...)
+}
+
+func (l *moduleLogger) With(keyvals ...interface{}) log.Logger {
+	return &moduleLogger{levels: l.levels, keyvals: append(append([]interface{}(nil), l.keyvals...), keyvals...)}
+}
+
+// logLevelsManager serves the runtime log levels on top of the manager
+type logLevelsManager struct {
+	pb.ManagerServiceServer
+	levels *LogLevels
+}
+
+func (m logLevelsManager) GetLogLevel(context.Context, *empty.Empty) (*pb.LogLevelResponse, error) {
+	return m.levels.Levels(), nil
+}
+
+func (m logLevelsManager) SetLogLevel(ctx context.Context, req *pb.SetLogLevelRequest) (*pb.LogLevelResponse, error) {
+	if req.Ttl < 0 {
+		return new(pb.LogLevelResponse), status.Errorf(codes.InvalidArgument, "invalid ttl: %d", req.Ttl)
+	}
+	if err := m.levels.Set(req.Level, time.Duration(req.Ttl)); err != nil {
+		return new(pb.LogLevelResponse), status.Error(codes.InvalidArgument, err.Error())
+	}
+	return m.levels.Levels(), nil
+}
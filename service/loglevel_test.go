@@ -0,0 +1,130 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"github.com/tendermint/tendermint/libs/log"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLogLevels(t *testing.T) {
+	var buf bytes.Buffer
+	levels, err := NewLogLevels(log.NewTMLogger(&buf), "consensus:error,*:info")
+	if err != nil {
+		t.Fatal(err)
+	}
+	consensus := levels.With("module", "consensus")
+	p2p := levels.With("module", "p2p")
+
+	logged := func(logger log.Logger) bool {
+		buf.Reset()
+		logger.Debug("debug")
+		logger.Info("info")
+		return strings.Contains(buf.String(), "debug")
+	}
+	if logged(consensus) || logged(p2p) {
+		t.Fatal("debug logged before the change")
+	}
+
+	if err := levels.Set("consensus:debug", 50*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	if !logged(consensus) || logged(p2p) {
+		t.Fatal("debug not logged for consensus only")
+	}
+	if modules := levels.Levels().Modules; modules[0].Module != "consensus" || modules[0].Until == "" {
+		t.Fatalf("unexpected levels: %v", modules)
+	}
+
+	time.Sleep(100 * time.Millisecond)
+	if logged(consensus) {
+		t.Fatal("debug logged after the ttl")
+	}
+	if level := levels.Levels().Level; level != "consensus:error,*:info" {
+		t.Fatalf("levels not reverted: %s", level)
+	}
+
+	if err := levels.Set("debug", 0); err != nil {
+		t.Fatal(err)
+	}
+	if !logged(p2p) {
+		t.Fatal("debug not logged after changing the default level")
+	}
+	if err := levels.Set("consensus:verbose", 0); err == nil {
+		t.Fatal("unknown level accepted")
+	}
+}
+
+// countingLogger counts the loggers derived with With
+type countingLogger struct {
+	log.Logger
+	with *int
+}
+
+func (l countingLogger) With(keyvals ...interface{}) log.Logger {
+	*l.with++
+	return countingLogger{Logger: l.Logger.With(keyvals...), with: l.with}
+}
+
+func TestLogLevelsWith(t *testing.T) {
+	with := 0
+	levels, err := NewLogLevels(countingLogger{Logger: log.NewNopLogger(), with: &with}, "info")
+	if err != nil {
+		t.Fatal(err)
+	}
+	consensus := levels.With("module", "consensus")
+	consensus.Info("info")
+	consensus.Info("info")
+	if with != 1 {
+		t.Errorf("derived %d loggers for two calls, want 1", with)
+	}
+
+	if err := levels.Set("consensus:debug", 0); err != nil {
+		t.Fatal(err)
+	}
+	consensus.Info("info")
+	if with != 2 {
+		t.Errorf("derived %d loggers after the change, want 2", with)
+	}
+}
+
+func TestLogLevelCommand(t *testing.T) {
+	b := newTestBackend(t)
+	levels, err := NewLogLevels(log.NewNopLogger(), "info")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir, err := ioutil.TempDir("", "loglevel")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	socketPath := filepath.Join(dir, "manager.sock")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		_ = StartCLIServer(socketPath, NewManagerWith(b.blockchain, b.rpc, b.node, b.cfg), ctx, WithLogLevels(levels))
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	console, err := ConfigureManagerConsole(socketPath, WithHistory(filepath.Join(dir, "history"), DefaultHistoryLimit, DefaultSecretPatterns))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := console.Execute([]string{"log_level", "set", "consensus:debug", "--for", "10m"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := console.Execute([]string{"log_level", "show", "--output", "table"}); err != nil {
+		t.Fatal(err)
+	}
+	response := levels.Levels()
+	if response.Level != "consensus:debug,*:info" || response.Modules[0].Until == "" {
+		t.Fatalf("unexpected levels: %v", response)
+	}
+}
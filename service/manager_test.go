@@ -0,0 +1,1153 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/MinterTeam/minter-go-node/config"
+	"github.com/MinterTeam/minter-go-node/core/minter"
+	"github.com/MinterTeam/minter-go-node/core/transaction"
+	minterTypes "github.com/MinterTeam/minter-go-node/core/types"
+	minterCrypto "github.com/MinterTeam/minter-go-node/crypto"
+	"github.com/MinterTeam/minter-go-node/rlp"
+	"github.com/MinterTeam/minter-node-cli/pb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/empty"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/p2p"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmState "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	testHeight = 100
+	testPeerID = p2p.ID("a4a8b9d2f3e0c1b2a4a8b9d2f3e0c1b2a4a8b9d2")
+	// testOtherPeerID is a peer which is not connected
+	testOtherPeerID = p2p.ID("c1b2a4a8b9d2f3e0c1b2a4a8b9d2f3e0c1b2a4a8")
+)
+
+type testBackend struct {
+	blockchain *fakeBlockchain
+	rpc        *fakeTendermintRPC
+	node       *fakeNode
+	cfg        *config.Config
+	pubKey     ed25519.PubKeyEd25519
+	tx         types.Tx
+	sender     string
+}
+
+// newTestBackend builds a node at testHeight, validating with one of its two validators,
+// with one connected peer, a send transaction in the last block and in the mempool
+func newTestBackend(t *testing.T) *testBackend {
+	b := &testBackend{
+		blockchain: newFakeBlockchain(testHeight),
+		rpc:        newFakeTendermintRPC(),
+		node:       newFakeNode(testHeight, testPeerID),
+		cfg:        config.DefaultConfig(),
+		pubKey:     ed25519.GenPrivKeyFromSecret([]byte("validator")).PubKey().(ed25519.PubKeyEd25519),
+	}
+	b.cfg.KeepLastStates = 10
+	b.cfg.RootDir = "/nonexistent"
+	b.node.pruned = b.blockchain.isPruned
+
+	b.tx, b.sender = testTransaction(t)
+
+	owner := minterTypes.Address{1}
+	pubKey := minterTypes.Pubkey(b.pubKey)
+	var tmAddress minterTypes.TmAddress
+	copy(tmAddress[:], b.pubKey.Address())
+	state := b.blockchain.state
+	state.Candidates.Create(owner, owner, pubKey, 10)
+	state.Candidates.SetOnline(pubKey)
+	state.Validators.Create(owner, pubKey, 10, big.NewInt(1))
+	state.Validators.SetValidatorAbsent(testHeight, tmAddress)
+
+	other := ed25519.GenPrivKeyFromSecret([]byte("other")).PubKey()
+	b.rpc.validators = []*types.Validator{
+		types.NewValidator(b.pubKey, 10),
+		types.NewValidator(other, 10),
+	}
+	b.rpc.status = &ctypes.ResultStatus{
+		NodeInfo: testNodeInfo("f3e0c1b2a4a8b9d2f3e0c1b2a4a8b9d2a4a8b9d2", "node"),
+		SyncInfo: ctypes.SyncInfo{LatestBlockHeight: testHeight, LatestBlockTime: testTime},
+		ValidatorInfo: ctypes.ValidatorInfo{
+			Address:     b.pubKey.Address(),
+			PubKey:      b.pubKey,
+			VotingPower: 10,
+		},
+	}
+	b.rpc.netInfo = &ctypes.ResultNetInfo{
+		Listening: true,
+		NPeers:    1,
+		Peers:     []ctypes.Peer{{NodeInfo: testNodeInfo(testPeerID, "peer"), RemoteIP: "10.0.0.1"}},
+	}
+	b.rpc.blocks[testHeight] = types.MakeBlock(testHeight, []types.Tx{b.tx}, &types.Commit{}, nil)
+	// the fake block has no hash, its header misses the validators
+	blockID := types.BlockID{Hash: tmhash.Sum([]byte("block"))}
+	b.rpc.commits[testHeight] = &types.Commit{BlockID: blockID, Precommits: []*types.CommitSig{
+		{ValidatorAddress: b.pubKey.Address(), BlockID: blockID, Signature: []byte{0x01}},
+		nil,
+	}}
+	b.rpc.results[testHeight] = &tmState.ABCIResponses{
+		DeliverTx: []*abci.ResponseDeliverTx{{
+			GasUsed: 10,
+			Events: []abci.Event{{
+				Type:       "tags",
+				Attributes: []common.KVPair{{Key: []byte("tx.type"), Value: []byte("01")}},
+			}},
+		}},
+		BeginBlock: &abci.ResponseBeginBlock{},
+		EndBlock:   &abci.ResponseEndBlock{},
+	}
+	b.rpc.txs[string(b.tx.Hash())] = &ctypes.ResultTx{Height: testHeight, Tx: b.tx}
+	b.rpc.unconfirmed = []types.Tx{b.tx, {0x01, 0x02}}
+
+	return b
+}
+
+// testTransaction returns a signed send transaction and its sender
+func testTransaction(t *testing.T) (types.Tx, string) {
+	privateKey, err := minterCrypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := rlp.EncodeToBytes(transaction.SendData{
+		Coin:  minterTypes.GetBaseCoin(),
+		To:    minterTypes.Address{2},
+		Value: big.NewInt(1),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := transaction.Transaction{
+		Nonce:         1,
+		ChainID:       minterTypes.CurrentChainID,
+		GasPrice:      1,
+		GasCoin:       minterTypes.GetBaseCoin(),
+		Type:          transaction.TypeSend,
+		Data:          data,
+		SignatureType: transaction.SigTypeSingle,
+	}
+	if err := tx.Sign(privateKey); err != nil {
+		t.Fatal(err)
+	}
+	encoded, err := tx.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return encoded, minterCrypto.PubkeyToAddress(privateKey.PublicKey).String()
+}
+
+// dialManager serves the manager over an in-memory connection
+func dialManager(t *testing.T, manager pb.ManagerServiceServer) (pb.ManagerServiceClient, func()) {
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	pb.RegisterManagerServiceServer(server, manager)
+	go func() { _ = server.Serve(lis) }()
+
+	conn, err := grpc.Dial("bufconn", grpc.WithInsecure(), grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+		return lis.Dial()
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pb.NewManagerServiceClient(conn), func() {
+		_ = conn.Close()
+		server.Stop()
+	}
+}
+
+// recvAll reads the stream until its end
+func recvAll(recv func() (proto.Message, error)) ([]proto.Message, error) {
+	var responses []proto.Message
+	for {
+		response, err := recv()
+		if err == io.EOF {
+			return responses, nil
+		}
+		if err != nil {
+			return responses, err
+		}
+		responses = append(responses, response)
+	}
+}
+
+// checkPruned checks that the blocks are pruned from one height to another and kept otherwise
+func checkPruned(t *testing.T, b *testBackend, from, to int64) {
+	for h := int64(1); h <= testHeight; h++ {
+		if pruned := h >= from && h <= to; b.node.BlockStored(h) == pruned {
+			t.Errorf("block %d pruned: %v", h, !pruned)
+		}
+	}
+}
+
+func TestManager(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(b *testBackend)
+		call  func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error)
+		code  codes.Code
+		check func(t *testing.T, b *testBackend, response interface{})
+	}{
+		{
+			name: "Status",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.Status(ctx, &empty.Empty{})
+			},
+			check: func(t *testing.T, b *testBackend, response interface{}) {
+				statusResponse := response.(*pb.StatusResponse)
+				if statusResponse.LatestBlockHeight != testHeight || statusResponse.KeepLastStates != 10 {
+					t.Errorf("unexpected status: %v", statusResponse)
+				}
+				if pubKey := statusResponse.TmStatus.ValidatorInfo.PubKey; pubKey.Value != minterTypes.Pubkey(b.pubKey).String() {
+					t.Errorf("unexpected pub key: %v", pubKey)
+				}
+			},
+		},
+		{
+			name:  "Status/internal",
+			setup: func(b *testBackend) { b.rpc.err = errFake },
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.Status(ctx, &empty.Empty{})
+			},
+			code: codes.Internal,
+		},
+		{
+			name: "WatchStatus",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				ctx, cancel := context.WithCancel(ctx)
+				defer cancel()
+				stream, err := client.WatchStatus(ctx, &pb.WatchStatusRequest{})
+				if err != nil {
+					return nil, err
+				}
+				var responses []*pb.StatusResponse
+				for i := 0; i < 2; i++ {
+					response, err := stream.Recv()
+					if err != nil {
+						return nil, err
+					}
+					responses = append(responses, response)
+					b.rpc.newBlocks <- ctypes.ResultEvent{}
+				}
+				return responses, nil
+			},
+			check: func(t *testing.T, b *testBackend, response interface{}) {
+				if responses := response.([]*pb.StatusResponse); len(responses) != 2 {
+					t.Errorf("got %d statuses", len(responses))
+				}
+			},
+		},
+		{
+			name: "WatchStatus/interval",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				stream, err := client.WatchStatus(ctx, &pb.WatchStatusRequest{Interval: -1})
+				if err != nil {
+					return nil, err
+				}
+				return stream.Recv()
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name:  "WatchStatus/internal",
+			setup: func(b *testBackend) { b.rpc.err = errFake },
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				stream, err := client.WatchStatus(ctx, &pb.WatchStatusRequest{})
+				if err != nil {
+					return nil, err
+				}
+				return stream.Recv()
+			},
+			code: codes.Internal,
+		},
+		{
+			name: "NetInfo",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.NetInfo(ctx, &empty.Empty{})
+			},
+			check: func(t *testing.T, b *testBackend, response interface{}) {
+				netInfo := response.(*pb.NetInfoResponse)
+				if netInfo.NPeers != 1 || netInfo.Peers[0].NodeInfo.Id != string(testPeerID) {
+					t.Errorf("unexpected net info: %v", netInfo)
+				}
+			},
+		},
+		{
+			name:  "NetInfo/internal",
+			setup: func(b *testBackend) { b.rpc.err = errFake },
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.NetInfo(ctx, &empty.Empty{})
+			},
+			code: codes.Internal,
+		},
+		{
+			name: "PruneBlocks",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.PruneBlocks(ctx, &pb.PruneBlocksRequest{FromHeight: 1, ToHeight: 95})
+			},
+			check: func(t *testing.T, b *testBackend, response interface{}) {
+				checkPruned(t, b, 1, 95)
+			},
+		},
+		{
+			name:  "PruneBlocks/internal",
+			setup: func(b *testBackend) { b.blockchain.pruneErr = errFake },
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.PruneBlocks(ctx, &pb.PruneBlocksRequest{FromHeight: 91, ToHeight: 95})
+			},
+			code: codes.Internal,
+		},
+		{
+			name: "PruneBlocks/range",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.PruneBlocks(ctx, &pb.PruneBlocksRequest{FromHeight: 95, ToHeight: 94})
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "PruneBlocks/current",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.PruneBlocks(ctx, &pb.PruneBlocksRequest{FromHeight: 95, ToHeight: testHeight})
+			},
+			code: codes.FailedPrecondition,
+		},
+		{
+			name: "PruneBlocksStream",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				stream, err := client.PruneBlocksStream(ctx, &pb.PruneBlocksRequest{FromHeight: 95, ToHeight: 96})
+				if err != nil {
+					return nil, err
+				}
+				return recvAll(func() (proto.Message, error) { return stream.Recv() })
+			},
+			check: func(t *testing.T, b *testBackend, response interface{}) {
+				responses := response.([]proto.Message)
+				last := responses[len(responses)-1].(*pb.PruneBlocksResponse)
+				if len(responses) != 2 || last.Current != 2 || last.Total != 2 || last.Height != 96 {
+					t.Errorf("unexpected progress: %v", responses)
+				}
+				checkPruned(t, b, 95, 96)
+			},
+		},
+		{
+			name:  "PruneBlocksStream/internal",
+			setup: func(b *testBackend) { b.blockchain.pruneErr = errFake },
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				stream, err := client.PruneBlocksStream(ctx, &pb.PruneBlocksRequest{FromHeight: 95, ToHeight: 96})
+				if err != nil {
+					return nil, err
+				}
+				return recvAll(func() (proto.Message, error) { return stream.Recv() })
+			},
+			code: codes.Internal,
+		},
+		{
+			name: "DealPeer",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.DealPeer(ctx, &pb.DealPeerRequest{Address: string(testPeerID) + "@10.0.0.2:26656"})
+			},
+			check: func(t *testing.T, b *testBackend, response interface{}) {
+				if len(b.rpc.dialed) != 1 {
+					t.Errorf("dialed %v", b.rpc.dialed)
+				}
+			},
+		},
+		{
+			name:  "DealPeer/failed",
+			setup: func(b *testBackend) { b.rpc.err = errFake },
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.DealPeer(ctx, &pb.DealPeerRequest{Address: string(testPeerID) + "@10.0.0.2:26656"})
+			},
+			code: codes.FailedPrecondition,
+		},
+		{
+			name: "DealPeer/banned",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				if _, err := client.BanPeer(ctx, &pb.BanPeerRequest{Id: string(testPeerID), Duration: int64(time.Hour)}); err != nil {
+					return nil, err
+				}
+				return client.DealPeer(ctx, &pb.DealPeerRequest{Address: string(testPeerID) + "@10.0.0.2:26656"})
+			},
+			code: codes.FailedPrecondition,
+		},
+		{
+			name: "DealPeer/invalid",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.DealPeer(ctx, &pb.DealPeerRequest{Address: "10.0.0.2:26656"})
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "StopPeer",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.StopPeer(ctx, &pb.StopPeerRequest{Id: strings.ToUpper(string(testPeerID))})
+			},
+			check: func(t *testing.T, b *testBackend, response interface{}) {
+				if len(b.node.stopped) != 1 || b.node.stopped[0] != testPeerID {
+					t.Errorf("stopped %v", b.node.stopped)
+				}
+			},
+		},
+		{
+			name: "StopPeer/not connected",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.StopPeer(ctx, &pb.StopPeerRequest{Id: strings.Repeat("0", 40)})
+			},
+			code: codes.NotFound,
+		},
+		{
+			name: "StopPeer/invalid id",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.StopPeer(ctx, &pb.StopPeerRequest{Id: "peer"})
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "BanPeer",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				if _, err := client.BanPeer(ctx, &pb.BanPeerRequest{Id: string(testPeerID), Duration: int64(time.Hour)}); err != nil {
+					return nil, err
+				}
+				return client.ListBannedPeers(ctx, &empty.Empty{})
+			},
+			check: func(t *testing.T, b *testBackend, response interface{}) {
+				bannedPeers := response.(*pb.BannedPeersResponse)
+				if len(bannedPeers.Peers) != 1 || bannedPeers.Peers[0].Id != string(testPeerID) {
+					t.Errorf("unexpected bans: %v", bannedPeers)
+				}
+				if len(b.node.stopped) != 1 {
+					t.Errorf("stopped %v", b.node.stopped)
+				}
+			},
+		},
+		{
+			name: "BanPeer/address book",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				book, cleanup, err := newFakeAddressBook(b.cfg, string(testPeerID)+"@10.0.0.1:26656")
+				if err != nil {
+					return nil, err
+				}
+				defer cleanup()
+				b.node.book = book
+				if _, err := client.BanPeer(ctx, &pb.BanPeerRequest{Id: string(testPeerID), Duration: int64(time.Hour)}); err != nil {
+					return nil, err
+				}
+				return book.HasAddress(&p2p.NetAddress{ID: testPeerID}), nil
+			},
+			check: func(t *testing.T, b *testBackend, response interface{}) {
+				if response.(bool) {
+					t.Error("the banned peer is still in the address book")
+				}
+			},
+		},
+		{
+			name: "BanPeer/persistent peers",
+			setup: func(b *testBackend) {
+				b.cfg.P2P.PersistentPeers = string(testOtherPeerID) + "@10.0.0.2:26656, " + string(testPeerID) + "@10.0.0.1:26656"
+			},
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.BanPeer(ctx, &pb.BanPeerRequest{Id: string(testPeerID), Duration: int64(time.Hour)})
+			},
+			code: codes.FailedPrecondition,
+		},
+		{
+			name:  "BanPeer/persistent connection",
+			setup: func(b *testBackend) { b.node.peers[testPeerID] = fakePeer{id: testPeerID, persistent: true} },
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.BanPeer(ctx, &pb.BanPeerRequest{Id: string(testPeerID), Duration: int64(time.Hour)})
+			},
+			code: codes.FailedPrecondition,
+		},
+		{
+			name: "BanPeer/persistent deal",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				if _, err := client.DealPeer(ctx, &pb.DealPeerRequest{Address: string(testOtherPeerID) + "@10.0.0.2:26656", Persistent: true}); err != nil {
+					return nil, err
+				}
+				return client.BanPeer(ctx, &pb.BanPeerRequest{Id: string(testOtherPeerID), Duration: int64(time.Hour)})
+			},
+			code: codes.FailedPrecondition,
+		},
+		{
+			name: "BanPeer/duration",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.BanPeer(ctx, &pb.BanPeerRequest{Id: string(testPeerID)})
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "UnbanPeer",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				if _, err := client.BanPeer(ctx, &pb.BanPeerRequest{Id: string(testPeerID), Duration: int64(time.Hour)}); err != nil {
+					return nil, err
+				}
+				if _, err := client.UnbanPeer(ctx, &pb.UnbanPeerRequest{Id: string(testPeerID)}); err != nil {
+					return nil, err
+				}
+				return client.ListBannedPeers(ctx, &empty.Empty{})
+			},
+			check: func(t *testing.T, b *testBackend, response interface{}) {
+				if bannedPeers := response.(*pb.BannedPeersResponse); len(bannedPeers.Peers) != 0 {
+					t.Errorf("unexpected bans: %v", bannedPeers)
+				}
+			},
+		},
+		{
+			name: "UnbanPeer/not banned",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.UnbanPeer(ctx, &pb.UnbanPeerRequest{Id: string(testPeerID)})
+			},
+			code: codes.NotFound,
+		},
+		{
+			name: "ListBannedPeers",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.ListBannedPeers(ctx, &empty.Empty{})
+			},
+			check: func(t *testing.T, b *testBackend, response interface{}) {
+				if bannedPeers := response.(*pb.BannedPeersResponse); len(bannedPeers.Peers) != 0 {
+					t.Errorf("unexpected bans: %v", bannedPeers)
+				}
+			},
+		},
+		{
+			name: "AuditLog/disabled",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.AuditLog(ctx, &pb.AuditLogRequest{})
+			},
+			code: codes.FailedPrecondition,
+		},
+		{
+			name: "Profile",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				stream, err := client.Profile(ctx, &pb.ProfileRequest{Kind: "goroutine"})
+				if err != nil {
+					return nil, err
+				}
+				return recvAll(func() (proto.Message, error) { return stream.Recv() })
+			},
+			check: func(t *testing.T, b *testBackend, response interface{}) {
+				responses := response.([]proto.Message)
+				// profiles are gzipped protobuf
+				if len(responses) == 0 || !bytes.HasPrefix(responses[0].(*pb.ProfileResponse).Data, []byte{0x1f, 0x8b}) {
+					t.Errorf("unexpected profile: %v", responses)
+				}
+			},
+		},
+		{
+			name: "Profile/unknown",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				stream, err := client.Profile(ctx, &pb.ProfileRequest{Kind: "disk"})
+				if err != nil {
+					return nil, err
+				}
+				return recvAll(func() (proto.Message, error) { return stream.Recv() })
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "Profile/seconds",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				stream, err := client.Profile(ctx, &pb.ProfileRequest{Kind: "cpu", Seconds: maxProfileSeconds + 1})
+				if err != nil {
+					return nil, err
+				}
+				return recvAll(func() (proto.Message, error) { return stream.Recv() })
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "Resources",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				root, err := ioutil.TempDir("", "resources")
+				if err != nil {
+					return nil, err
+				}
+				defer os.RemoveAll(root)
+				b.cfg.RootDir = root
+				for _, path := range []string{"data/state.db/000001.ldb", "tmdata/blockstore.db/000001.ldb"} {
+					path = filepath.Join(root, path)
+					if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+						return nil, err
+					}
+					if err := ioutil.WriteFile(path, make([]byte, 100), 0600); err != nil {
+						return nil, err
+					}
+				}
+				return client.Resources(ctx, &empty.Empty{})
+			},
+			check: func(t *testing.T, b *testBackend, response interface{}) {
+				resources := response.(*pb.ResourcesResponse)
+				databases := resources.Databases
+				if len(databases) != 2 || databases[0].Name != "data/state.db" || databases[1].Name != "tmdata/blockstore.db" || databases[1].Size != 100 {
+					t.Errorf("unexpected databases: %v", databases)
+				}
+				if resources.Goroutines == 0 || resources.MemStats.Sys == 0 || resources.DiskTotal == 0 {
+					t.Errorf("unexpected resources: %v", resources)
+				}
+			},
+		},
+		{
+			name: "Health",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.Health(ctx, &pb.HealthRequest{MinPeers: 1, MinFreeDisk: 10})
+			},
+			check: func(t *testing.T, b *testBackend, response interface{}) {
+				health := response.(*pb.HealthResponse)
+				if !health.Healthy || len(health.Checks) != 3 {
+					t.Errorf("unexpected health: %v", health)
+				}
+			},
+		},
+		{
+			name: "Health/unhealthy",
+			setup: func(b *testBackend) {
+				b.rpc.status.SyncInfo.CatchingUp = true
+			},
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.Health(ctx, &pb.HealthRequest{MinPeers: 2, MaxBlockAge: int64(time.Minute)})
+			},
+			check: func(t *testing.T, b *testBackend, response interface{}) {
+				health := response.(*pb.HealthResponse)
+				if health.Healthy || len(health.Checks) != 3 {
+					t.Errorf("unexpected health: %v", health)
+				}
+				for _, check := range health.Checks {
+					if check.Healthy {
+						t.Errorf("unexpected passed check: %v", check)
+					}
+				}
+			},
+		},
+		{
+			name: "Health/invalid",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.Health(ctx, &pb.HealthRequest{MinFreeDisk: 200})
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "AddressBook",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				book, cleanup, err := newFakeAddressBook(b.cfg, string(testPeerID)+"@10.0.0.1:26656", string(testOtherPeerID)+"@10.0.0.2:26656")
+				if err != nil {
+					return nil, err
+				}
+				defer cleanup()
+				b.node.book = book
+				book.MarkGood(testOtherPeerID)
+				book.Save()
+				return client.AddressBook(ctx, &empty.Empty{})
+			},
+			check: func(t *testing.T, b *testBackend, response interface{}) {
+				if response.(*pb.AddressBookResponse).SavedAt == "" {
+					t.Error("the saving time of the address book is missing")
+				}
+				addresses := response.(*pb.AddressBookResponse).Addresses
+				if len(addresses) != 2 {
+					t.Fatalf("unexpected addresses: %v", addresses)
+				}
+				if addresses[0].Id != string(testOtherPeerID) || addresses[0].Bucket != "old" || addresses[0].LastSuccess == "" {
+					t.Errorf("unexpected good address: %v", addresses[0])
+				}
+				if addresses[1].Id != string(testPeerID) || addresses[1].Address != "10.0.0.1:26656" || addresses[1].Bucket != "new" || addresses[1].LastSuccess != "" {
+					t.Errorf("unexpected new address: %v", addresses[1])
+				}
+			},
+		},
+		{
+			name: "AddressBook/stale",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				book, cleanup, err := newFakeAddressBook(b.cfg, string(testPeerID)+"@10.0.0.1:26656")
+				if err != nil {
+					return nil, err
+				}
+				defer cleanup()
+				b.node.book = book
+				book.Save()
+				if _, err := client.AddAddress(ctx, &pb.AddAddressRequest{Address: string(testOtherPeerID) + "@10.0.0.2:26656"}); err != nil {
+					return nil, err
+				}
+				return client.AddressBook(ctx, &empty.Empty{})
+			},
+			check: func(t *testing.T, b *testBackend, response interface{}) {
+				// listing does not save the book
+				if addresses := response.(*pb.AddressBookResponse).Addresses; len(addresses) != 1 || addresses[0].Id != string(testPeerID) {
+					t.Errorf("unexpected addresses: %v", addresses)
+				}
+			},
+		},
+		{
+			name: "AddressBook/not saved",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				book, cleanup, err := newFakeAddressBook(b.cfg, string(testPeerID)+"@10.0.0.1:26656")
+				if err != nil {
+					return nil, err
+				}
+				defer cleanup()
+				b.node.book = book
+				return client.AddressBook(ctx, &empty.Empty{})
+			},
+			check: func(t *testing.T, b *testBackend, response interface{}) {
+				if addressBook := response.(*pb.AddressBookResponse); len(addressBook.Addresses) != 0 || addressBook.SavedAt != "" {
+					t.Errorf("unexpected address book: %v", addressBook)
+				}
+			},
+		},
+		{
+			name: "AddressBook/none",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.AddressBook(ctx, &empty.Empty{})
+			},
+			code: codes.FailedPrecondition,
+		},
+		{
+			name: "AddAddress",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				book, cleanup, err := newFakeAddressBook(b.cfg)
+				if err != nil {
+					return nil, err
+				}
+				defer cleanup()
+				b.node.book = book
+				if _, err := client.AddAddress(ctx, &pb.AddAddressRequest{Address: string(testOtherPeerID) + "@10.0.0.2:26656"}); err != nil {
+					return nil, err
+				}
+				book.Save()
+				return client.AddressBook(ctx, &empty.Empty{})
+			},
+			check: func(t *testing.T, b *testBackend, response interface{}) {
+				if addresses := response.(*pb.AddressBookResponse).Addresses; len(addresses) != 1 || addresses[0].Id != string(testOtherPeerID) {
+					t.Errorf("unexpected addresses: %v", addresses)
+				}
+			},
+		},
+		{
+			name: "AddAddress/invalid",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				book, cleanup, err := newFakeAddressBook(b.cfg)
+				if err != nil {
+					return nil, err
+				}
+				defer cleanup()
+				b.node.book = book
+				return client.AddAddress(ctx, &pb.AddAddressRequest{Address: "10.0.0.2:26656"})
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "RemoveAddress",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				book, cleanup, err := newFakeAddressBook(b.cfg, string(testPeerID)+"@10.0.0.1:26656")
+				if err != nil {
+					return nil, err
+				}
+				defer cleanup()
+				b.node.book = book
+				if _, err := client.RemoveAddress(ctx, &pb.AddressRequest{Id: string(testPeerID)}); err != nil {
+					return nil, err
+				}
+				return client.RemoveAddress(ctx, &pb.AddressRequest{Id: string(testPeerID)})
+			},
+			code: codes.NotFound,
+		},
+		{
+			name: "MarkAddressGood",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				book, cleanup, err := newFakeAddressBook(b.cfg, string(testPeerID)+"@10.0.0.1:26656")
+				if err != nil {
+					return nil, err
+				}
+				defer cleanup()
+				b.node.book = book
+				if _, err := client.MarkAddressGood(ctx, &pb.AddressRequest{Id: string(testPeerID)}); err != nil {
+					return nil, err
+				}
+				book.Save()
+				return client.AddressBook(ctx, &empty.Empty{})
+			},
+			check: func(t *testing.T, b *testBackend, response interface{}) {
+				if addresses := response.(*pb.AddressBookResponse).Addresses; len(addresses) != 1 || addresses[0].Bucket != "old" {
+					t.Errorf("unexpected addresses: %v", addresses)
+				}
+			},
+		},
+		{
+			name: "MarkAddressBad",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				book, cleanup, err := newFakeAddressBook(b.cfg, string(testPeerID)+"@10.0.0.1:26656")
+				if err != nil {
+					return nil, err
+				}
+				defer cleanup()
+				b.node.book = book
+				if _, err := client.MarkAddressBad(ctx, &pb.AddressRequest{Id: string(testPeerID)}); err != nil {
+					return nil, err
+				}
+				book.Save()
+				return client.AddressBook(ctx, &empty.Empty{})
+			},
+			check: func(t *testing.T, b *testBackend, response interface{}) {
+				if addresses := response.(*pb.AddressBookResponse).Addresses; len(addresses) != 0 {
+					t.Errorf("unexpected addresses: %v", addresses)
+				}
+			},
+		},
+		{
+			name: "SaveAddressBook",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				book, cleanup, err := newFakeAddressBook(b.cfg, string(testPeerID)+"@10.0.0.1:26656")
+				if err != nil {
+					return nil, err
+				}
+				defer cleanup()
+				b.node.book = book
+				if _, err := client.SaveAddressBook(ctx, &empty.Empty{}); err != nil {
+					return nil, err
+				}
+				return ioutil.ReadFile(b.cfg.P2P.AddrBookFile())
+			},
+			check: func(t *testing.T, b *testBackend, response interface{}) {
+				if data := response.([]byte); !bytes.Contains(data, []byte(testPeerID)) {
+					t.Errorf("unexpected address book file: %s", data)
+				}
+			},
+		},
+		{
+			name: "GetLogLevel/disabled",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.GetLogLevel(ctx, &empty.Empty{})
+			},
+			code: codes.FailedPrecondition,
+		},
+		{
+			name: "SetLogLevel/disabled",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.SetLogLevel(ctx, &pb.SetLogLevelRequest{Level: "debug"})
+			},
+			code: codes.FailedPrecondition,
+		},
+		{
+			name: "UnconfirmedTxs",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.UnconfirmedTxs(ctx, &pb.UnconfirmedTxsRequest{})
+			},
+			check: func(t *testing.T, b *testBackend, response interface{}) {
+				unconfirmedTxs := response.(*pb.UnconfirmedTxsResponse)
+				if unconfirmedTxs.NTxs != 2 || unconfirmedTxs.Txs[0].From != b.sender || unconfirmedTxs.Txs[0].Type != "send" {
+					t.Errorf("unexpected txs: %v", unconfirmedTxs)
+				}
+			},
+		},
+		{
+			name: "UnconfirmedTxs/sender",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.UnconfirmedTxs(ctx, &pb.UnconfirmedTxsRequest{Sender: strings.ToUpper(b.sender)})
+			},
+			check: func(t *testing.T, b *testBackend, response interface{}) {
+				if unconfirmedTxs := response.(*pb.UnconfirmedTxsResponse); unconfirmedTxs.NTxs != 1 || unconfirmedTxs.Total != 2 || unconfirmedTxs.Truncated {
+					t.Errorf("unexpected txs: %v", unconfirmedTxs)
+				}
+			},
+		},
+		{
+			name: "UnconfirmedTxs/truncated",
+			setup: func(b *testBackend) {
+				for len(b.rpc.unconfirmed) <= maxUnconfirmedTxs {
+					b.rpc.unconfirmed = append(b.rpc.unconfirmed, types.Tx{0x01, 0x02})
+				}
+			},
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.UnconfirmedTxs(ctx, &pb.UnconfirmedTxsRequest{Sender: b.sender})
+			},
+			check: func(t *testing.T, b *testBackend, response interface{}) {
+				if unconfirmedTxs := response.(*pb.UnconfirmedTxsResponse); unconfirmedTxs.NTxs != 1 || !unconfirmedTxs.Truncated {
+					t.Errorf("unexpected txs: %v", unconfirmedTxs)
+				}
+			},
+		},
+		{
+			name: "UnconfirmedTxs/limit",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.UnconfirmedTxs(ctx, &pb.UnconfirmedTxsRequest{Limit: -1})
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name:  "UnconfirmedTxs/internal",
+			setup: func(b *testBackend) { b.rpc.err = errFake },
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.UnconfirmedTxs(ctx, &pb.UnconfirmedTxsRequest{})
+			},
+			code: codes.Internal,
+		},
+		{
+			name: "NumUnconfirmedTxs",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.NumUnconfirmedTxs(ctx, &empty.Empty{})
+			},
+			check: func(t *testing.T, b *testBackend, response interface{}) {
+				if numTxs := response.(*pb.NumUnconfirmedTxsResponse); numTxs.NTxs != 2 || numTxs.TotalBytes != int64(len(b.tx)+2) {
+					t.Errorf("unexpected count: %v", numTxs)
+				}
+			},
+		},
+		{
+			name:  "NumUnconfirmedTxs/internal",
+			setup: func(b *testBackend) { b.rpc.err = errFake },
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.NumUnconfirmedTxs(ctx, &empty.Empty{})
+			},
+			code: codes.Internal,
+		},
+		{
+			name: "FlushMempool",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.FlushMempool(ctx, &empty.Empty{})
+			},
+			check: func(t *testing.T, b *testBackend, response interface{}) {
+				if b.node.flushed != 1 {
+					t.Errorf("flushed %d times", b.node.flushed)
+				}
+			},
+		},
+		{
+			name: "Block",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.Block(ctx, &pb.BlockRequest{})
+			},
+			check: func(t *testing.T, b *testBackend, response interface{}) {
+				block := response.(*pb.BlockResponse)
+				if block.Height != testHeight || len(block.Transactions) != 1 || block.Transactions[0].From != b.sender {
+					t.Errorf("unexpected block: %v", block)
+				}
+				if len(block.Signatures) != 2 || !block.Signatures[0].Signed || block.MissingSignatures != 1 {
+					t.Errorf("unexpected signatures: %v", block.Signatures)
+				}
+			},
+		},
+		{
+			name: "Block/nil vote",
+			setup: func(b *testBackend) {
+				b.rpc.commits[testHeight].Precommits[0].BlockID = types.BlockID{}
+			},
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.Block(ctx, &pb.BlockRequest{})
+			},
+			check: func(t *testing.T, b *testBackend, response interface{}) {
+				if block := response.(*pb.BlockResponse); block.Signatures[0].Signed || block.MissingSignatures != 2 {
+					t.Errorf("a nil vote counted as signed: %v", block.Signatures)
+				}
+			},
+		},
+		{
+			name: "Block/not committed",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.Block(ctx, &pb.BlockRequest{Height: testHeight + 1})
+			},
+			code: codes.NotFound,
+		},
+		{
+			name: "Block/not stored",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.Block(ctx, &pb.BlockRequest{Height: 1})
+			},
+			code: codes.NotFound,
+		},
+		{
+			name:  "Block/pruned",
+			setup: func(b *testBackend) { b.blockchain.pruned[testHeight] = true },
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.Block(ctx, &pb.BlockRequest{Height: testHeight})
+			},
+			code: codes.NotFound,
+		},
+		{
+			name: "Block/height",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.Block(ctx, &pb.BlockRequest{Height: -1})
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name:  "Block/internal",
+			setup: func(b *testBackend) { b.rpc.err = errFake },
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.Block(ctx, &pb.BlockRequest{})
+			},
+			code: codes.Internal,
+		},
+		{
+			name: "BlockResults",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.BlockResults(ctx, &pb.BlockRequest{Height: testHeight})
+			},
+			check: func(t *testing.T, b *testBackend, response interface{}) {
+				results := response.(*pb.BlockResultsResponse)
+				if len(results.DeliverTx) != 1 || results.DeliverTx[0].Events[0].Attributes[0].Key != "tx.type" {
+					t.Errorf("unexpected results: %v", results)
+				}
+			},
+		},
+		{
+			name: "BlockResults/not stored",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.BlockResults(ctx, &pb.BlockRequest{Height: 1})
+			},
+			code: codes.NotFound,
+		},
+		{
+			name:  "BlockResults/internal",
+			setup: func(b *testBackend) { b.rpc.err = errFake },
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.BlockResults(ctx, &pb.BlockRequest{Height: 1})
+			},
+			code: codes.Internal,
+		},
+		{
+			name: "Tx",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.Tx(ctx, &pb.TxRequest{Hash: fmt.Sprintf("Mt%x", b.tx.Hash())})
+			},
+			check: func(t *testing.T, b *testBackend, response interface{}) {
+				tx := response.(*pb.TxResponse)
+				if tx.Height != testHeight || tx.Transaction.From != b.sender || tx.Transaction.Nonce != 1 {
+					t.Errorf("unexpected tx: %v", tx)
+				}
+			},
+		},
+		{
+			name: "Tx/not found",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.Tx(ctx, &pb.TxRequest{Hash: "Mt" + strings.Repeat("00", 32)})
+			},
+			code: codes.NotFound,
+		},
+		{
+			name: "Tx/hash",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.Tx(ctx, &pb.TxRequest{Hash: "Mt00"})
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name:  "Tx/internal",
+			setup: func(b *testBackend) { b.rpc.err = errFake },
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.Tx(ctx, &pb.TxRequest{Hash: fmt.Sprintf("Mt%x", b.tx.Hash())})
+			},
+			code: codes.Internal,
+		},
+		{
+			name: "Validator",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.Validator(ctx, &pb.ValidatorRequest{Blocks: 10})
+			},
+			check: func(t *testing.T, b *testBackend, response interface{}) {
+				validator := response.(*pb.ValidatorResponse)
+				if !validator.Candidate || validator.CandidateStatus != "online" || validator.Commission != 10 || !validator.Active {
+					t.Errorf("unexpected candidate: %v", validator)
+				}
+				if validator.Blocks != 10 || validator.SignedBlocks != 1 || validator.MissedBlocks != 1 {
+					t.Errorf("unexpected signatures: %v", validator)
+				}
+			},
+		},
+		{
+			name: "Validator/pruned",
+			setup: func(b *testBackend) {
+				for h := int64(1); h < testHeight; h++ {
+					b.blockchain.pruned[h] = true
+				}
+			},
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.Validator(ctx, &pb.ValidatorRequest{Blocks: 10})
+			},
+			check: func(t *testing.T, b *testBackend, response interface{}) {
+				if validator := response.(*pb.ValidatorResponse); validator.Blocks != 1 || validator.SignedBlocks != 1 {
+					t.Errorf("unexpected signatures: %v", validator)
+				}
+			},
+		},
+		{
+			name: "Validator/nil vote",
+			setup: func(b *testBackend) {
+				b.rpc.commits[testHeight].Precommits[0].BlockID = types.BlockID{}
+			},
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.Validator(ctx, &pb.ValidatorRequest{Blocks: 10})
+			},
+			check: func(t *testing.T, b *testBackend, response interface{}) {
+				if validator := response.(*pb.ValidatorResponse); validator.Blocks != 10 || validator.SignedBlocks != 0 {
+					t.Errorf("a nil vote counted as signed: %v", validator)
+				}
+			},
+		},
+		{
+			name: "Validator/blocks",
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.Validator(ctx, &pb.ValidatorRequest{Blocks: -1})
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name:  "Validator/internal",
+			setup: func(b *testBackend) { b.rpc.err = errFake },
+			call: func(ctx context.Context, client pb.ManagerServiceClient, b *testBackend) (interface{}, error) {
+				return client.Validator(ctx, &pb.ValidatorRequest{})
+			},
+			code: codes.Internal,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b := newTestBackend(t)
+			if test.setup != nil {
+				test.setup(b)
+			}
+			client, closeClient := dialManager(t, NewManagerWith(b.blockchain, b.rpc, b.node, b.cfg))
+			defer closeClient()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			response, err := test.call(ctx, client, b)
+			if code := status.Code(err); code != test.code {
+				t.Fatalf("got code %s (%v), want %s", code, err, test.code)
+			}
+			if err == nil && test.check != nil {
+				test.check(t, b, response)
+			}
+		})
+	}
+}
+
+// TestPruneBlocksUnsupported checks that a blockchain which cannot prune under its commit lock refuses pruning
+func TestPruneBlocksUnsupported(t *testing.T) {
+	b := newTestBackend(t)
+	client, closeClient := dialManager(t, NewManagerWith((*minter.Blockchain)(nil), b.rpc, b.node, b.cfg))
+	defer closeClient()
+
+	_, err := client.PruneBlocks(context.Background(), &pb.PruneBlocksRequest{FromHeight: 1, ToHeight: 2})
+	if code := status.Code(err); code != codes.FailedPrecondition {
+		t.Errorf("PruneBlocks() code = %s (%v), want %s", code, err, codes.FailedPrecondition)
+	}
+}
@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"github.com/MinterTeam/minter-node-cli/pb"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"path"
+	"time"
+)
+
+const metricsNamespace = "minter"
+
+var (
+	heightDesc = prometheus.NewDesc(
+		metricsNamespace+"_height", "Height of the latest block.", nil, nil)
+	catchingUpDesc = prometheus.NewDesc(
+		metricsNamespace+"_catching_up", "Whether the node is catching up, 1 or 0.", nil, nil)
+	peersDesc = prometheus.NewDesc(
+		metricsNamespace+"_n_peers", "Number of connected peers.", nil, nil)
+	peerLabels        = []string{"peer_id", "moniker"}
+	peerSendBytesDesc = prometheus.NewDesc(
+		metricsNamespace+"_peer_send_bytes", "Bytes sent to the peer over the connection.", peerLabels, nil)
+	peerRecvBytesDesc = prometheus.NewDesc(
+		metricsNamespace+"_peer_recv_bytes", "Bytes received from the peer over the connection.", peerLabels, nil)
+	peerSendRateDesc = prometheus.NewDesc(
+		metricsNamespace+"_peer_send_rate_bytes", "Current send rate to the peer in bytes per second.", peerLabels, nil)
+	peerRecvRateDesc = prometheus.NewDesc(
+		metricsNamespace+"_peer_recv_rate_bytes", "Current receive rate from the peer in bytes per second.", peerLabels, nil)
+)
+
+// metricsTimeout bounds the manager calls made on a scrape
+const metricsTimeout = 5 * time.Second
+
+// managerMetrics exports the status and the network data of the manager to Prometheus,
+// along with the latency and the errors of the manager methods
+type managerMetrics struct {
+	manager  pb.ManagerServiceServer
+	registry *prometheus.Registry
+	latency  *prometheus.HistogramVec
+	errors   *prometheus.CounterVec
+}
+
+func newMetrics(manager pb.ManagerServiceServer) *managerMetrics {
+	m := &managerMetrics{
+		manager:  manager,
+		registry: prometheus.NewRegistry(),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Subsystem: "manager",
+			Name:      "rpc_duration_seconds",
+			Help:      "Latency of the manager methods, streams last until their end.",
+		}, []string{"method"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: "manager",
+			Name:      "rpc_errors_total",
+			Help:      "Number of manager calls which failed, by status code.",
+		}, []string{"method", "code"}),
+	}
+	m.registry.MustRegister(m, m.latency, m.errors)
+	return m
+}
+
+// Describe implements prometheus.Collector
+func (m *managerMetrics) Describe(ch chan<- *prometheus.Desc) {
+	for _, desc := range []*prometheus.Desc{heightDesc, catchingUpDesc, peersDesc, peerSendBytesDesc, peerRecvBytesDesc, peerSendRateDesc, peerRecvRateDesc} {
+		ch <- desc
+	}
+}
+
+// Collect implements prometheus.Collector, calling Status and NetInfo on every scrape
+func (m *managerMetrics) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), metricsTimeout)
+	defer cancel()
+
+	if statusResponse, err := m.manager.Status(ctx, &empty.Empty{}); err != nil {
+		ch <- prometheus.NewInvalidMetric(heightDesc, err)
+	} else {
+		catchingUp := 0.0
+		if statusResponse.GetTmStatus().GetSyncInfo().GetCatchingUp() {
+			catchingUp = 1
+		}
+		ch <- prometheus.MustNewConstMetric(heightDesc, prometheus.GaugeValue, float64(statusResponse.LatestBlockHeight))
+		ch <- prometheus.MustNewConstMetric(catchingUpDesc, prometheus.GaugeValue, catchingUp)
+	}
+
+	netInfo, err := m.manager.NetInfo(ctx, &empty.Empty{})
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(peersDesc, err)
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(peersDesc, prometheus.GaugeValue, float64(netInfo.NPeers))
+	for _, peer := range netInfo.Peers {
+		connection := peer.GetConnectionStatus()
+		labels := []string{peer.GetNodeInfo().GetId(), peer.GetNodeInfo().GetMoniker()}
+		ch <- prometheus.MustNewConstMetric(peerSendBytesDesc, prometheus.GaugeValue, float64(connection.GetSendMonitor().GetBytes()), labels...)
+		ch <- prometheus.MustNewConstMetric(peerRecvBytesDesc, prometheus.GaugeValue, float64(connection.GetRecvMonitor().GetBytes()), labels...)
+		ch <- prometheus.MustNewConstMetric(peerSendRateDesc, prometheus.GaugeValue, float64(connection.GetSendMonitor().GetCurRate()), labels...)
+		ch <- prometheus.MustNewConstMetric(peerRecvRateDesc, prometheus.GaugeValue, float64(connection.GetRecvMonitor().GetCurRate()), labels...)
+	}
+}
+
+// registerAudit exports the number of calls missing from the audit log
+func (m *managerMetrics) registerAudit(audit *AuditLog) {
+	m.registry.MustRegister(prometheus.NewCounterFunc(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "manager",
+		Name:      "audit_failures_total",
+		Help:      "Number of mutating calls whose audit entry could not be written.",
+	}, func() float64 {
+		return float64(audit.Failures())
+	}))
+}
+
+// handler serves the metrics in the Prometheus text format,
+// the metrics that cannot be collected are left out of the scrape
+func (m *managerMetrics) handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{ErrorHandling: promhttp.ContinueOnError})
+}
+
+func (m *managerMetrics) observe(method string, start time.Time, err error) {
+	method = path.Base(method)
+	m.latency.WithLabelValues(method).Observe(time.Since(start).Seconds())
+	if err != nil {
+		m.errors.WithLabelValues(method, status.Code(err).String()).Inc()
+	}
+}
+
+func (m *managerMetrics) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	res, err := handler(ctx, req)
+	m.observe(info.FullMethod, start, err)
+	return res, err
+}
+
+func (m *managerMetrics) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	m.observe(info.FullMethod, start, err)
+	return err
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"github.com/MinterTeam/minter-node-cli/pb"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMetrics(t *testing.T) {
+	b := newTestBackend(t)
+	metrics := newMetrics(NewManagerWith(b.blockchain, b.rpc, b.node, b.cfg))
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/pb.ManagerService/Tx"}
+	_, _ = metrics.unaryInterceptor(context.Background(), nil, info, func(context.Context, interface{}) (interface{}, error) {
+		return nil, status.Error(codes.NotFound, "tx not found")
+	})
+
+	recorder := httptest.NewRecorder()
+	metrics.handler().ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+	body, err := ioutil.ReadAll(recorder.Result().Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{
+		"minter_height 100",
+		"minter_catching_up 0",
+		"minter_n_peers 1",
+		`minter_peer_send_rate_bytes{moniker="peer",peer_id="` + string(testPeerID) + `"} 0`,
+		`minter_manager_rpc_duration_seconds_count{method="Tx"} 1`,
+		`minter_manager_rpc_errors_total{code="NotFound",method="Tx"} 1`,
+	} {
+		if !strings.Contains(string(body), line+"\n") {
+			t.Errorf("missing %q in\n%s", line, body)
+		}
+	}
+
+	// the RPC metrics are still exported when the node cannot be reached
+	b.rpc.err = errFake
+	recorder = httptest.NewRecorder()
+	metrics.handler().ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+	body, _ = ioutil.ReadAll(recorder.Result().Body)
+	if strings.Contains(string(body), "minter_height") || !strings.Contains(string(body), "minter_manager_rpc_errors_total") {
+		t.Errorf("unexpected metrics:\n%s", body)
+	}
+}
+
+func TestMetricsListener(t *testing.T) {
+	dir, err := ioutil.TempDir("", "metrics")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	address := lis.Addr().String()
+	_ = lis.Close()
+
+	b := newTestBackend(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	socketPath := filepath.Join(dir, "manager.sock")
+	errs := make(chan error, 1)
+	go func() {
+		errs <- StartCLIServer(socketPath, NewManagerWith(b.blockchain, b.rpc, b.node, b.cfg), ctx, WithMetricsListener(address))
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	cc, err := grpc.Dial("passthrough:///unix:///"+socketPath, grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cc.Close()
+	if _, err := pb.NewManagerServiceClient(cc).Status(context.Background(), &empty.Empty{}); err != nil {
+		t.Fatal(err)
+	}
+
+	response, err := http.Get("http://" + address + "/metrics")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := ioutil.ReadAll(response.Body)
+	_ = response.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{
+		"minter_height 100",
+		`minter_manager_rpc_duration_seconds_count{method="Status"} 1`,
+	} {
+		if !strings.Contains(string(body), line+"\n") {
+			t.Errorf("missing %q in\n%s", line, body)
+		}
+	}
+
+	cancel()
+	if err := <-errs; err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	eventsdb "github.com/MinterTeam/events-db"
+	"github.com/MinterTeam/minter-go-node/core/state"
+	tmNode "github.com/tendermint/tendermint/node"
+	"github.com/tendermint/tendermint/p2p"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+// BlockPruner deletes the stored blocks and state versions of the heights from to, running under the commit lock
+// of the node so that it cannot race the node saving and deleting its own state versions on commit.
+// minter.Blockchain does not implement it at the minter-go-node version this module depends on,
+// PruneBlocks is refused until it does.
+//
+// Tendermint reads blocks assuming that all the heights up to the latest are stored (its RPC /commit
+// dereferences the block meta of the height), the manager checks Node.BlockStored before reading a block.
+type BlockPruner interface {
+	PruneBlocks(from, to int64) error
+}
+
+// Blockchain is the part of the Minter application read by the manager, implemented by minter.Blockchain
+type Blockchain interface {
+	Height() uint64
+	CurrentState() *state.State
+	GetEventsDB() eventsdb.IEventsDB
+}
+
+// NodeStatus reports the status of the node and its new blocks
+type NodeStatus interface {
+	Status() (*ctypes.ResultStatus, error)
+	Subscribe(ctx context.Context, subscriber, query string, outCapacity ...int) (<-chan ctypes.ResultEvent, error)
+	UnsubscribeAll(ctx context.Context, subscriber string) error
+}
+
+// Network reports the network state of the node and its peers
+type Network interface {
+	NetInfo() (*ctypes.ResultNetInfo, error)
+}
+
+// PeerDialer connects the node to new peers
+type PeerDialer interface {
+	DialPeers(peers []string, persistent bool) (*ctypes.ResultDialPeers, error)
+}
+
+// Blocks reads the committed blocks with their results, signatures and transactions
+type Blocks interface {
+	Block(height *int64) (*ctypes.ResultBlock, error)
+	BlockResults(height *int64) (*ctypes.ResultBlockResults, error)
+	Commit(height *int64) (*ctypes.ResultCommit, error)
+	Validators(height *int64) (*ctypes.ResultValidators, error)
+	// TxSearch finds transactions by hash: an unknown hash gives no results, unlike Tx which fails with a message
+	TxSearch(query string, prove bool, page, perPage int) (*ctypes.ResultTxSearch, error)
+}
+
+// Mempool reads the unconfirmed transactions
+type Mempool interface {
+	UnconfirmedTxs(limit int) (*ctypes.ResultUnconfirmedTxs, error)
+	NumUnconfirmedTxs() (*ctypes.ResultUnconfirmedTxs, error)
+}
+
+// TendermintRPC is the Tendermint RPC used by the manager, implemented by rpc.Local
+type TendermintRPC interface {
+	NodeStatus
+	Network
+	PeerDialer
+	Blocks
+	Mempool
+}
+
+// PeerSwitch disconnects peers, implemented by p2p.Switch
+type PeerSwitch interface {
+	Peers() p2p.IPeerSet
+	StopPeerGracefully(peer p2p.Peer)
+}
+
+// AddressBook is the book of known peer addresses, implemented by pex.AddrBook
+type AddressBook interface {
+	AddAddress(addr *p2p.NetAddress, src *p2p.NetAddress) error
+	RemoveAddress(addr *p2p.NetAddress)
+	HasAddress(addr *p2p.NetAddress) bool
+	MarkGood(id p2p.ID)
+	MarkBad(addr *p2p.NetAddress)
+	Save()
+}
+
+// AddressBookNode is a Node with an address book, saved to config.P2P.AddrBookFile(), nil if the node has none.
+// It must be the book the node runs with: a second book opened on the same file is not the one the node dials
+// from, and the two overwrite each other's saves. Tendermint v0.32.6 keeps the address book of its node private,
+// so the node of NewNode has none and the address book methods of the manager are refused.
+type AddressBookNode interface {
+	AddressBook() AddressBook
+}
+
+// Node is the part of the Tendermint node changed by the manager, see NewNode
+type Node interface {
+	PeerSwitch
+	FlushMempool()
+	BlockStoreHeight() int64
+	// BlockStored tells whether the block of the height is still stored, see BlockPruner
+	BlockStored(height int64) bool
+}
+
+type tendermintNode struct {
+	node *tmNode.Node
+}
+
+// NewNode adapts the Tendermint node for the manager
+func NewNode(node *tmNode.Node) Node {
+	return tendermintNode{node: node}
+}
+
+func (n tendermintNode) Peers() p2p.IPeerSet {
+	return n.node.Switch().Peers()
+}
+
+func (n tendermintNode) StopPeerGracefully(peer p2p.Peer) {
+	n.node.Switch().StopPeerGracefully(peer)
+}
+
+func (n tendermintNode) FlushMempool() {
+	n.node.Mempool().Flush()
+}
+
+func (n tendermintNode) BlockStoreHeight() int64 {
+	return n.node.BlockStore().Height()
+}
+
+func (n tendermintNode) BlockStored(height int64) bool {
+	return n.node.BlockStore().LoadBlockMeta(height) != nil
+}
@@ -0,0 +1,325 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/MinterTeam/minter-node-cli/pb"
+	"github.com/golang/protobuf/proto"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+	"io/ioutil"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// localNode is the name of the node given by the socket path or the TLS endpoint of the console
+const localNode = "local"
+
+// NodeProfile is the connection to a node, either its manager socket
+// or its TLS endpoint with the client certificate and the CA of the server
+type NodeProfile struct {
+	Socket  string `json:"socket"`
+	Address string `json:"address"`
+	Cert    string `json:"cert"`
+	Key     string `json:"key"`
+	CA      string `json:"ca"`
+}
+
+// Profiles are the named nodes the console can switch between and fan out to
+type Profiles struct {
+	// Default is the node used when the console starts, the local one if empty
+	Default string                 `json:"default"`
+	Nodes   map[string]NodeProfile `json:"nodes"`
+}
+
+// LoadProfiles reads a JSON profiles file:
+//
+//	{"default": "validator", "nodes": {"validator": {"socket": "/home/minter/.minter/manager.sock"},
+//	 "sentry": {"address": "10.0.0.2:8843", "cert": "client.crt", "key": "client.key", "ca": "ca.crt"}}}
+func LoadProfiles(path string) (*Profiles, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var profiles Profiles
+	if err := json.Unmarshal(data, &profiles); err != nil {
+		return nil, fmt.Errorf("profiles %s: %s", path, err)
+	}
+	for name, profile := range profiles.Nodes {
+		if name == "" || strings.ContainsAny(name, ", ") {
+			return nil, fmt.Errorf("profiles %s: invalid node name %q", path, name)
+		}
+		if name == localNode {
+			return nil, fmt.Errorf("profiles %s: node name %s is reserved for the socket or TLS endpoint of the console", path, name)
+		}
+		if (profile.Socket == "") == (profile.Address == "") {
+			return nil, fmt.Errorf("profiles %s: node %s needs either a socket or an address", path, name)
+		}
+	}
+	if _, ok := profiles.Nodes[profiles.Default]; profiles.Default != "" && !ok {
+		return nil, fmt.Errorf("profiles %s: unknown default node %s", path, profiles.Default)
+	}
+	return &profiles, nil
+}
+
+// target returns the dial target of the node and the transport option for it
+func (p NodeProfile) target() (string, grpc.DialOption, error) {
+	if p.Socket != "" {
+		return "passthrough:///unix:///" + p.Socket, grpc.WithInsecure(), nil
+	}
+	config, err := NewClientTLSConfig(p.Cert, p.Key, p.CA)
+	if err != nil {
+		return "", nil, err
+	}
+	return p.Address, grpc.WithTransportCredentials(credentials.NewTLS(config)), nil
+}
+
+// consoleNodes are the manager clients of the console, commands call the current one
+type consoleNodes struct {
+	mu      sync.Mutex
+	names   []string
+	clients map[string]pb.ManagerServiceClient
+	conns   map[string]*nodeConnection
+	current string
+}
+
+func newConsoleNodes() *consoleNodes {
+	return &consoleNodes{clients: make(map[string]pb.ManagerServiceClient), conns: make(map[string]*nodeConnection)}
+}
+
+// add makes the connection a node of the console, unless the name is taken
+func (n *consoleNodes) add(name string, conn *nodeConnection) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if _, ok := n.clients[name]; ok {
+		return fmt.Errorf("duplicate node name %s", name)
+	}
+	n.names = append(n.names, name)
+	sort.Strings(n.names)
+	n.clients[name] = pb.NewManagerServiceClient(conn.cc)
+	n.conns[name] = conn
+	if n.current == "" {
+		n.current = name
+	}
+	return nil
+}
+
+// close closes the connections to all the nodes
+func (n *consoleNodes) close() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	for _, conn := range n.conns {
+		_ = conn.cc.Close()
+	}
+}
+
+// client returns the client of the current node
+func (n *consoleNodes) client() pb.ManagerServiceClient {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return n.clients[n.current]
+}
+
+func (n *consoleNodes) currentName() string {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return n.current
+}
+
+// currentStatus returns the connection status of the current node
+func (n *consoleNodes) currentStatus() string {
+	n.mu.Lock()
+	conn := n.conns[n.current]
+	n.mu.Unlock()
+
+	return conn.status()
+}
+
+func (n *consoleNodes) use(name string) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if _, ok := n.clients[name]; !ok {
+		return fmt.Errorf("unknown node %s, expected one of %s", name, strings.Join(n.names, ", "))
+	}
+	n.current = name
+	return nil
+}
+
+// selected returns the nodes chosen by the --all and --nodes flags, none without them
+func (n *consoleNodes) selected(c *cli.Context) ([]string, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if c.Bool("all") {
+		return append([]string(nil), n.names...), nil
+	}
+	if c.String("nodes") == "" {
+		return nil, nil
+	}
+	var names []string
+	for _, name := range strings.Split(c.String("nodes"), ",") {
+		name = strings.TrimSpace(name)
+		if _, ok := n.clients[name]; !ok {
+			return nil, fmt.Errorf("unknown node %s, expected one of %s", name, strings.Join(n.names, ", "))
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// nodeResult is the response or the error of one node of a fan-out
+type nodeResult struct {
+	name     string
+	response proto.Message
+	err      error
+}
+
+// fanOut calls the nodes in parallel and returns their results in the order of the names
+func (n *consoleNodes) fanOut(ctx context.Context, names []string, call func(ctx context.Context, client pb.ManagerServiceClient) (proto.Message, error)) []nodeResult {
+	results := make([]nodeResult, len(names))
+	var wg sync.WaitGroup
+	for i, name := range names {
+		n.mu.Lock()
+		client := n.clients[name]
+		n.mu.Unlock()
+
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+			response, err := call(ctx, client)
+			results[i] = nodeResult{name: name, response: response, err: err}
+		}(i, name)
+	}
+	wg.Wait()
+	return results
+}
+
+// nodesFlags select the nodes of a fan-out command
+func nodesFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.BoolFlag{Name: "all", Required: false, Usage: "run on every node of the profiles"},
+		&cli.StringFlag{Name: "nodes", Required: false, Usage: "run on the nodes, e.g. validator,sentry"},
+	}
+}
+
+// nodesError reports the nodes which failed in a fan-out, after their errors were displayed inline
+func nodesError(results []nodeResult) error {
+	var failed []string
+	for _, result := range results {
+		if result.err != nil {
+			failed = append(failed, result.name)
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%d of %d nodes failed: %s", len(failed), len(results), strings.Join(failed, ", "))
+}
+
+func nodeError(err error) string {
+	if err == nil {
+		return ""
+	}
+	if s, ok := status.FromError(err); ok {
+		return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+	}
+	return err.Error()
+}
+
+// nodesStatus is the status of every node of the console, merged into one response by the console
+type nodesStatus struct {
+	Nodes []*nodeStatus `json:"nodes"`
+}
+
+type nodeStatus struct {
+	Name   string             `json:"name"`
+	Error  string             `json:"error"`
+	Status *pb.StatusResponse `json:"status"`
+}
+
+func (m *nodesStatus) Reset()         { *m = nodesStatus{} }
+func (m *nodesStatus) String() string { return m.text() }
+func (*nodesStatus) ProtoMessage()    {}
+
+func (m *nodesStatus) text() string {
+	var b strings.Builder
+	for _, node := range m.Nodes {
+		writeNodeText(&b, node.Name, node.Error, "status", node.Status)
+	}
+	return b.String()
+}
+
+// nodesNetInfo is the net_info of every node of the console, merged into one response by the console
+type nodesNetInfo struct {
+	Nodes []*nodeNetInfo `json:"nodes"`
+}
+
+type nodeNetInfo struct {
+	Name    string              `json:"name"`
+	Error   string              `json:"error"`
+	NetInfo *pb.NetInfoResponse `json:"net_info"`
+}
+
+func (m *nodesNetInfo) Reset()         { *m = nodesNetInfo{} }
+func (m *nodesNetInfo) String() string { return m.text() }
+func (*nodesNetInfo) ProtoMessage()    {}
+
+func (m *nodesNetInfo) text() string {
+	var b strings.Builder
+	for _, node := range m.Nodes {
+		writeNodeText(&b, node.Name, node.Error, "net_info", node.NetInfo)
+	}
+	return b.String()
+}
+
+// writeNodeText writes the response of a node in the protobuf text format, as if nodes were a repeated field
+func writeNodeText(b *strings.Builder, name, err, field string, response proto.Message) {
+	_, _ = fmt.Fprintf(b, "nodes: <\n  name: %q\n  error: %q\n", name, err)
+	if !reflect.ValueOf(response).IsNil() {
+		_, _ = fmt.Fprintf(b, "  %s: <\n", field)
+		for _, line := range strings.SplitAfter(proto.MarshalTextString(response), "\n") {
+			if line != "" {
+				b.WriteString("    " + line)
+			}
+		}
+		b.WriteString("  >\n")
+	}
+	b.WriteString(">\n")
+}
+
+// statusOfNodes merges the status results into a nodesStatus
+func statusOfNodes(results []nodeResult) *nodesStatus {
+	response := &nodesStatus{}
+	for _, result := range results {
+		node := &nodeStatus{Name: result.name, Error: nodeError(result.err)}
+		if result.err == nil {
+			node.Status = result.response.(*pb.StatusResponse)
+		}
+		response.Nodes = append(response.Nodes, node)
+	}
+	return response
+}
+
+// netInfoOfNodes merges the net_info results into a nodesNetInfo
+func netInfoOfNodes(results []nodeResult) *nodesNetInfo {
+	response := &nodesNetInfo{}
+	for _, result := range results {
+		node := &nodeNetInfo{Name: result.name, Error: nodeError(result.err)}
+		if result.err == nil {
+			node.NetInfo = result.response.(*pb.NetInfoResponse)
+		}
+		response.Nodes = append(response.Nodes, node)
+	}
+	return response
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/MinterTeam/minter-node-cli/pb"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLoadProfiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "profiles")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, tt := range []struct {
+		data string
+		err  string
+	}{
+		{data: `{"default": "a", "nodes": {"a": {"socket": "/a.sock"}, "b": {"address": "10.0.0.2:8843"}}}`},
+		{data: `{"nodes": {"a": {"socket": "/a.sock", "address": "10.0.0.2:8843"}}}`, err: "either a socket or an address"},
+		{data: `{"nodes": {"a,b": {"socket": "/a.sock"}}}`, err: "invalid node name"},
+		{data: `{"nodes": {"local": {"socket": "/a.sock"}}}`, err: "reserved"},
+		{data: `{"default": "c", "nodes": {"a": {"socket": "/a.sock"}}}`, err: "unknown default node"},
+	} {
+		path := filepath.Join(dir, "profiles.json")
+		if err := ioutil.WriteFile(path, []byte(tt.data), 0600); err != nil {
+			t.Fatal(err)
+		}
+		_, err := LoadProfiles(path)
+		if (tt.err == "" && err != nil) || (tt.err != "" && (err == nil || !strings.Contains(err.Error(), tt.err))) {
+			t.Errorf("LoadProfiles(%s) error = %v, want %q", tt.data, err, tt.err)
+		}
+	}
+}
+
+func TestDialNodesDuplicate(t *testing.T) {
+	profiles := &Profiles{Nodes: map[string]NodeProfile{localNode: {Socket: filepath.Join(os.TempDir(), "other.sock")}}}
+	_, err := ConfigureManagerConsole(filepath.Join(os.TempDir(), "manager.sock"), WithProfiles(profiles), WithHistory("", 0, nil))
+	if err == nil || !strings.Contains(err.Error(), "duplicate node name") {
+		t.Errorf("ConfigureManagerConsole() error = %v", err)
+	}
+}
+
+func TestConsoleNodes(t *testing.T) {
+	dir, err := ioutil.TempDir("", "nodes")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	backends := map[string]*testBackend{"a": newTestBackend(t), "b": newTestBackend(t)}
+	profiles := &Profiles{Default: "b", Nodes: map[string]NodeProfile{"down": {Socket: filepath.Join(dir, "down.sock")}}}
+	for name, b := range backends {
+		socketPath := filepath.Join(dir, name+".sock")
+		profiles.Nodes[name] = NodeProfile{Socket: socketPath}
+		go func(b *testBackend) {
+			_ = StartCLIServer(socketPath, NewManagerWith(b.blockchain, b.rpc, b.node, b.cfg), ctx)
+		}(b)
+	}
+	time.Sleep(10 * time.Millisecond)
+
+	console, err := ConfigureManagerConsole("", WithProfiles(profiles), WithHistory(filepath.Join(dir, "history"), DefaultHistoryLimit, DefaultSecretPatterns))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(console.prefix(), "[b: ") {
+		t.Errorf("prefix() = %q", console.prefix())
+	}
+
+	if err := console.Execute([]string{"status", "--nodes", "a,b"}); err != nil {
+		t.Fatal(err)
+	}
+	err = console.Execute([]string{"net_info", "--all"})
+	if err == nil || err.Error() != "1 of 3 nodes failed: down" {
+		t.Fatalf("net_info --all error = %v", err)
+	}
+
+	if err := console.Execute([]string{"use", "a"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := console.Execute([]string{"stop_peer", "--id", string(testPeerID)}); err != nil {
+		t.Fatal(err)
+	}
+	if len(backends["a"].node.stopped) != 1 || len(backends["b"].node.stopped) != 0 {
+		t.Errorf("stopped a: %v, b: %v", backends["a"].node.stopped, backends["b"].node.stopped)
+	}
+	if err := console.Execute([]string{"use", "c"}); err == nil {
+		t.Error("used an unknown node")
+	}
+}
+
+func TestNodesNetInfoTable(t *testing.T) {
+	response := netInfoOfNodes([]nodeResult{
+		{name: "a", response: &pb.NetInfoResponse{Peers: []*pb.NetInfoResponse_Peer{{NodeInfo: &pb.NodeInfo{Id: "f6c0a7b4", Moniker: "seed"}, RemoteIp: "10.0.0.1"}}}},
+		{name: "down", err: errors.New("connection refused")},
+	})
+	header, rows := nodesNetInfoTable(response)
+	want := [][]string{
+		{"a", "seed", "f6c0a7b4", "10.0.0.1", "inbound", "", "", "", ""},
+		{"down", "", "", "", "", "", "", "", "connection refused"},
+	}
+	if len(header) != 9 || !reflect.DeepEqual(rows, want) {
+		t.Errorf("nodesNetInfoTable() = %v %v", header, rows)
+	}
+}
+
+func TestNodesStatusText(t *testing.T) {
+	response := statusOfNodes([]nodeResult{
+		{name: "a", response: &pb.StatusResponse{Version: "1.0.5"}},
+		{name: "down", err: errors.New("connection refused")},
+	})
+	var buf bytes.Buffer
+	if err := formatText(&buf, response); err != nil {
+		t.Fatal(err)
+	}
+	want := "nodes: <\n  name: \"a\"\n  error: \"\"\n  status: <\n    version: \"1.0.5\"\n  >\n>\n" +
+		"nodes: <\n  name: \"down\"\n  error: \"connection refused\"\n>\n\n"
+	if buf.String() != want {
+		t.Errorf("formatText() = %q, want %q", buf.String(), want)
+	}
+}
@@ -0,0 +1,396 @@
+package service
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"github.com/MinterTeam/minter-node-cli/pb"
+	"github.com/golang/protobuf/proto"
+	"github.com/urfave/cli/v2"
+	"gopkg.in/yaml.v2"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"text/template"
+	"time"
+)
+
+// Formatter writes a response in one output format
+type Formatter func(w io.Writer, response proto.Message) error
+
+// Table returns the header and rows of a response displayed as a table
+type Table func(response proto.Message) (header []string, rows [][]string)
+
+const templateOutput = "template="
+
+var (
+	formatters = map[string]Formatter{
+		"text":  formatText,
+		"json":  formatJSON,
+		"yaml":  formatYAML,
+		"table": formatTable,
+		"csv":   formatCSV,
+	}
+	tables = map[reflect.Type]Table{
+		reflect.TypeOf(&pb.NetInfoResponse{}):        netInfoTable,
+		reflect.TypeOf(&pb.BannedPeersResponse{}):    bannedPeersTable,
+		reflect.TypeOf(&pb.AuditLogResponse{}):       auditLogTable,
+		reflect.TypeOf(&pb.UnconfirmedTxsResponse{}): unconfirmedTxsTable,
+		reflect.TypeOf(&pb.LogLevelResponse{}):       logLevelTable,
+		reflect.TypeOf(&pb.HealthResponse{}):         healthTable,
+		reflect.TypeOf(&nodesStatus{}):               nodesStatusTable,
+		reflect.TypeOf(&nodesNetInfo{}):              nodesNetInfoTable,
+		reflect.TypeOf(&pb.AddressBookResponse{}):    addressBookTable,
+	}
+)
+
+// RegisterFormatter makes the output format available through --output
+func RegisterFormatter(name string, formatter Formatter) {
+	formatters[name] = formatter
+}
+
+// RegisterTable sets the table and csv layout of the response type,
+// responses without a layout are displayed as field and value pairs
+func RegisterTable(response proto.Message, table Table) {
+	tables[reflect.TypeOf(response)] = table
+}
+
+func outputFlag(value string) *cli.StringFlag {
+	names := make([]string, 0, len(formatters)+1)
+	for name := range formatters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	names = append(names, templateOutput+"<go-template>")
+	return &cli.StringFlag{Name: "output", Aliases: []string{"o"}, Required: false, Value: value, Usage: strings.Join(names, "|")}
+}
+
+// formatterFor resolves an --output value
+func formatterFor(output string) (Formatter, error) {
+	if strings.HasPrefix(output, templateOutput) {
+		tmpl, err := template.New("output").Parse(strings.TrimPrefix(output, templateOutput))
+		if err != nil {
+			return nil, err
+		}
+		return func(w io.Writer, response proto.Message) error {
+			if err := tmpl.Execute(w, response); err != nil {
+				return err
+			}
+			_, err := fmt.Fprintln(w)
+			return err
+		}, nil
+	}
+	formatter, ok := formatters[output]
+	if !ok {
+		return nil, fmt.Errorf("unknown output format %q", output)
+	}
+	return formatter, nil
+}
+
+// printResponse writes the response to stdout in the format chosen by the command flags,
+// falling back to the global --output of the console
+func printResponse(c *cli.Context, response proto.Message) error {
+	return printResponseAs(c, response, "")
+}
+
+// printResponseAs is printResponse with the format used when none is given on the command line,
+// instead of the default of the global --output
+func printResponseAs(c *cli.Context, response proto.Message, format string) error {
+	output := ""
+	for _, ctx := range c.Lineage() {
+		if format != "" && !ctx.IsSet("output") {
+			continue
+		}
+		if output = ctx.String("output"); output != "" {
+			break
+		}
+	}
+	if c.Bool("json") {
+		output = "json"
+	}
+	if output == "" {
+		output = format
+	}
+	if output == "" {
+		output = "text"
+	}
+	formatter, err := formatterFor(output)
+	if err != nil {
+		return err
+	}
+	return formatter(os.Stdout, response)
+}
+
+// consoleText is a response merged by the console, which has no protobuf descriptor to be marshalled with
+type consoleText interface {
+	text() string
+}
+
+func formatText(w io.Writer, response proto.Message) error {
+	if response, ok := response.(consoleText); ok {
+		_, err := fmt.Fprintln(w, response.text())
+		return err
+	}
+	_, err := fmt.Fprintln(w, proto.MarshalTextString(response))
+	return err
+}
+
+func formatJSON(w io.Writer, response proto.Message) error {
+	bytes, err := json.Marshal(response)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(bytes))
+	return err
+}
+
+func formatYAML(w io.Writer, response proto.Message) error {
+	value, err := orderedValue(response)
+	if err != nil {
+		return err
+	}
+	bytes, err := yaml.Marshal(value)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(bytes)
+	return err
+}
+
+func formatTable(w io.Writer, response proto.Message) error {
+	header, rows, err := tableOf(response)
+	if err != nil {
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, strings.ToUpper(strings.Join(header, "\t")))
+	for _, row := range rows {
+		_, _ = fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
+
+func formatCSV(w io.Writer, response proto.Message) error {
+	header, rows, err := tableOf(response)
+	if err != nil {
+		return err
+	}
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	if err := cw.WriteAll(rows); err != nil {
+		return err
+	}
+	return cw.Error()
+}
+
+func tableOf(response proto.Message) ([]string, [][]string, error) {
+	if table, ok := tables[reflect.TypeOf(response)]; ok {
+		header, rows := table(response)
+		return header, rows, nil
+	}
+
+	value, err := orderedValue(response)
+	if err != nil {
+		return nil, nil, err
+	}
+	var rows [][]string
+	flatten("", value, func(key, value string) {
+		rows = append(rows, []string{key, value})
+	})
+	return []string{"field", "value"}, rows, nil
+}
+
+// orderedValue converts the response to a tree of yaml.MapSlice keeping the field order of its json encoding
+func orderedValue(response proto.Message) (interface{}, error) {
+	data, err := json.Marshal(response)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decodeOrdered(decoder)
+}
+
+func decodeOrdered(decoder *json.Decoder) (interface{}, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch token {
+	case json.Delim('{'):
+		var object yaml.MapSlice
+		for decoder.More() {
+			key, err := decoder.Token()
+			if err != nil {
+				return nil, err
+			}
+			value, err := decodeOrdered(decoder)
+			if err != nil {
+				return nil, err
+			}
+			object = append(object, yaml.MapItem{Key: key, Value: value})
+		}
+		_, err := decoder.Token()
+		return object, err
+	case json.Delim('['):
+		list := make([]interface{}, 0)
+		for decoder.More() {
+			value, err := decodeOrdered(decoder)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, value)
+		}
+		_, err := decoder.Token()
+		return list, err
+	}
+	return token, nil
+}
+
+// flatten calls fn for every scalar of the tree with its dotted path
+func flatten(prefix string, value interface{}, fn func(key, value string)) {
+	join := func(key string) string {
+		if prefix == "" {
+			return key
+		}
+		return prefix + "." + key
+	}
+	switch value := value.(type) {
+	case yaml.MapSlice:
+		for _, item := range value {
+			flatten(join(fmt.Sprint(item.Key)), item.Value, fn)
+		}
+	case []interface{}:
+		for i, item := range value {
+			flatten(join(strconv.Itoa(i)), item, fn)
+		}
+	case nil:
+		fn(prefix, "")
+	default:
+		fn(prefix, fmt.Sprint(value))
+	}
+}
+
+func netInfoTable(response proto.Message) ([]string, [][]string) {
+	netInfo := response.(*pb.NetInfoResponse)
+	rows := make([][]string, 0, len(netInfo.Peers))
+	for _, peer := range netInfo.Peers {
+		direction := "inbound"
+		if peer.IsOutbound {
+			direction = "outbound"
+		}
+		row := []string{peer.GetNodeInfo().GetMoniker(), peer.GetNodeInfo().GetId(), peer.RemoteIp, direction, "", "", ""}
+		if connection := peer.ConnectionStatus; connection != nil {
+			row[4] = time.Duration(connection.Duration).Round(time.Second).String()
+			row[5] = formatBytes(connection.GetSendMonitor().GetCurRate()) + "/s"
+			row[6] = formatBytes(connection.GetRecvMonitor().GetCurRate()) + "/s"
+		}
+		rows = append(rows, row)
+	}
+	return []string{"moniker", "id", "remote ip", "direction", "duration", "send rate", "recv rate"}, rows
+}
+
+func bannedPeersTable(response proto.Message) ([]string, [][]string) {
+	bannedPeers := response.(*pb.BannedPeersResponse)
+	rows := make([][]string, 0, len(bannedPeers.Peers))
+	for _, peer := range bannedPeers.Peers {
+		rows = append(rows, []string{peer.Id, peer.Until})
+	}
+	return []string{"id", "until"}, rows
+}
+
+func auditLogTable(response proto.Message) ([]string, [][]string) {
+	auditLog := response.(*pb.AuditLogResponse)
+	rows := make([][]string, 0, len(auditLog.Entries))
+	for _, entry := range auditLog.Entries {
+		rows = append(rows, []string{entry.Time, entry.Caller, entry.Method, entry.Code, time.Duration(entry.Latency).String(), entry.Request})
+	}
+	return []string{"time", "caller", "method", "code", "latency", "request"}, rows
+}
+
+func unconfirmedTxsTable(response proto.Message) ([]string, [][]string) {
+	unconfirmedTxs := response.(*pb.UnconfirmedTxsResponse)
+	return transactionsTable(unconfirmedTxs.Txs)
+}
+
+func transactionsTable(txs []*pb.Transaction) ([]string, [][]string) {
+	rows := make([][]string, 0, len(txs))
+	for _, tx := range txs {
+		rows = append(rows, []string{tx.Hash, tx.From, strconv.FormatUint(tx.Nonce, 10), tx.Type, tx.GasCoin})
+	}
+	return []string{"hash", "from", "nonce", "type", "gas coin"}, rows
+}
+
+func logLevelTable(response proto.Message) ([]string, [][]string) {
+	logLevel := response.(*pb.LogLevelResponse)
+	rows := make([][]string, 0, len(logLevel.Modules))
+	for _, module := range logLevel.Modules {
+		rows = append(rows, []string{module.Module, module.Level, module.Until})
+	}
+	return []string{"module", "level", "until"}, rows
+}
+
+func healthTable(response proto.Message) ([]string, [][]string) {
+	health := response.(*pb.HealthResponse)
+	rows := make([][]string, 0, len(health.Checks))
+	for _, check := range health.Checks {
+		state := "ok"
+		if !check.Healthy {
+			state = "failed"
+		}
+		rows = append(rows, []string{check.Name, state, check.Message})
+	}
+	return []string{"check", "state", "message"}, rows
+}
+
+func nodesStatusTable(response proto.Message) ([]string, [][]string) {
+	nodes := response.(*nodesStatus)
+	rows := make([][]string, 0, len(nodes.Nodes))
+	for _, node := range nodes.Nodes {
+		row := []string{node.Name, "", "", "", "", node.Error}
+		if status := node.Status; status != nil {
+			row[1] = strconv.FormatInt(status.LatestBlockHeight, 10)
+			row[2] = status.LatestBlockTime
+			row[3] = strconv.FormatBool(status.GetTmStatus().GetSyncInfo().GetCatchingUp())
+			row[4] = status.Version
+		}
+		rows = append(rows, row)
+	}
+	return []string{"node", "height", "block time", "catching up", "version", "error"}, rows
+}
+
+// nodesNetInfoTable lists the peers of every node, nodes without peers get a row of their own
+func nodesNetInfoTable(response proto.Message) ([]string, [][]string) {
+	nodes := response.(*nodesNetInfo)
+	header, _ := netInfoTable(&pb.NetInfoResponse{})
+	var rows [][]string
+	for _, node := range nodes.Nodes {
+		var peers [][]string
+		if node.NetInfo != nil {
+			_, peers = netInfoTable(node.NetInfo)
+		}
+		if len(peers) == 0 {
+			peers = [][]string{make([]string, len(header))}
+		}
+		for _, peer := range peers {
+			rows = append(rows, append(append([]string{node.Name}, peer...), node.Error))
+		}
+	}
+	return append(append([]string{"node"}, header...), "error"), rows
+}
+
+func addressBookTable(response proto.Message) ([]string, [][]string) {
+	addressBook := response.(*pb.AddressBookResponse)
+	rows := make([][]string, 0, len(addressBook.Addresses))
+	for _, address := range addressBook.Addresses {
+		rows = append(rows, []string{address.Id, address.Address, address.Bucket, strconv.Itoa(int(address.Attempts)), address.LastSuccess, address.LastAttempt, address.Source})
+	}
+	return []string{"id", "address", "bucket", "attempts", "last success", "last attempt", "source"}, rows
+}
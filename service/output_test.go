@@ -0,0 +1,64 @@
+package service
+
+import (
+	"bytes"
+	"github.com/MinterTeam/minter-node-cli/pb"
+	"github.com/golang/protobuf/proto"
+	"testing"
+	"time"
+)
+
+func TestFormatters(t *testing.T) {
+	netInfo := &pb.NetInfoResponse{
+		Listening: true,
+		NPeers:    1,
+		Peers: []*pb.NetInfoResponse_Peer{
+			{
+				NodeInfo:   &pb.NodeInfo{Id: "f6c0a7b4", Moniker: "seed"},
+				IsOutbound: true,
+				ConnectionStatus: &pb.NetInfoResponse_Peer_ConnectionStatus{
+					Duration:    int64(90 * time.Second),
+					SendMonitor: &pb.NetInfoResponse_Peer_ConnectionStatus_Monitor{CurRate: 2048},
+					RecvMonitor: &pb.NetInfoResponse_Peer_ConnectionStatus_Monitor{CurRate: 100},
+				},
+				RemoteIp: "10.0.0.1",
+			},
+		},
+	}
+	status := &pb.StatusResponse{Version: "1.0.5", LatestBlockHeight: 42}
+
+	for _, tt := range []struct {
+		output   string
+		response proto.Message
+		want     string
+	}{
+		{"table", netInfo, "" +
+			"MONIKER  ID        REMOTE IP  DIRECTION  DURATION  SEND RATE  RECV RATE\n" +
+			"seed     f6c0a7b4  10.0.0.1   outbound   1m30s     2.0 KiB/s  100 B/s\n"},
+		{"csv", netInfo, "" +
+			"moniker,id,remote ip,direction,duration,send rate,recv rate\n" +
+			"seed,f6c0a7b4,10.0.0.1,outbound,1m30s,2.0 KiB/s,100 B/s\n"},
+		{"template={{.Version}}@{{.LatestBlockHeight}}", status, "1.0.5@42\n"},
+		{"csv", &pb.WatchStatusRequest{Interval: 5}, "field,value\ninterval,5\n"},
+		{"yaml", &pb.BannedPeersResponse{Peers: []*pb.BannedPeersResponse_Peer{{Id: "ab", Until: "2019-11-13T11:00:00Z"}}}, "" +
+			"peers:\n" +
+			"- id: ab\n" +
+			"  until: \"2019-11-13T11:00:00Z\"\n"},
+	} {
+		formatter, err := formatterFor(tt.output)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var buf bytes.Buffer
+		if err := formatter(&buf, tt.response); err != nil {
+			t.Fatal(err)
+		}
+		if buf.String() != tt.want {
+			t.Errorf("%s output:\n%s\nwant:\n%s", tt.output, buf.String(), tt.want)
+		}
+	}
+
+	if _, err := formatterFor("xml"); err == nil {
+		t.Error("formatterFor() should reject unknown formats")
+	}
+}
@@ -0,0 +1,32 @@
+package service
+
+import (
+	"errors"
+	"net"
+	"syscall"
+)
+
+func readPeerCredentials(conn net.Conn) (*PeerCredentials, error) {
+	unixConn, ok := conn.(*net.UnixConn)
+	if !ok {
+		return nil, errors.New("peer credentials are only available for unix sockets")
+	}
+	raw, err := unixConn.SyscallConn()
+	if err != nil {
+		return nil, err
+	}
+
+	var ucred *syscall.Ucred
+	var credErr error
+	err = raw.Control(func(fd uintptr) {
+		ucred, credErr = syscall.GetsockoptUcred(int(fd), syscall.SOL_SOCKET, syscall.SO_PEERCRED)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if credErr != nil {
+		return nil, credErr
+	}
+
+	return &PeerCredentials{Pid: ucred.Pid, Uid: ucred.Uid, Gid: ucred.Gid}, nil
+}
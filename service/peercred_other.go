@@ -0,0 +1,13 @@
+//go:build !linux
+// +build !linux
+
+package service
+
+import (
+	"errors"
+	"net"
+)
+
+func readPeerCredentials(conn net.Conn) (*PeerCredentials, error) {
+	return nil, errors.New("peer credentials are not supported on this platform")
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"bytes"
+	"github.com/MinterTeam/minter-node-cli/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"runtime/pprof"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	cpuProfile            = "cpu"
+	defaultProfileSeconds = 30
+	maxProfileSeconds     = 300
+	profileChunkSize      = 32 * 1024
+)
+
+// profileKinds returns cpu and the names of the runtime profiles, e.g. heap and goroutine
+func profileKinds() []string {
+	kinds := []string{cpuProfile}
+	for _, profile := range pprof.Profiles() {
+		kinds = append(kinds, profile.Name())
+	}
+	sort.Strings(kinds[1:])
+	return kinds
+}
+
+// Profile sends the pprof profile of the node in chunks. The cpu profile is collected for the given seconds,
+// the other profiles are a snapshot. The profile is stopped early when the client cancels the call.
+func (m *Manager) Profile(req *pb.ProfileRequest, stream pb.ManagerService_ProfileServer) error {
+	var buf bytes.Buffer
+	if req.Kind == cpuProfile {
+		seconds := req.Seconds
+		if seconds == 0 {
+			seconds = defaultProfileSeconds
+		}
+		if seconds < 0 || seconds > maxProfileSeconds {
+			return status.Errorf(codes.InvalidArgument, "seconds must be between 1 and %d", maxProfileSeconds)
+		}
+		if err := pprof.StartCPUProfile(&buf); err != nil {
+			return status.Error(codes.FailedPrecondition, err.Error())
+		}
+		timer := time.NewTimer(time.Duration(seconds) * time.Second)
+		select {
+		case <-timer.C:
+			pprof.StopCPUProfile()
+		case <-stream.Context().Done():
+			timer.Stop()
+			pprof.StopCPUProfile()
+			return status.FromContextError(stream.Context().Err()).Err()
+		}
+	} else {
+		profile := pprof.Lookup(req.Kind)
+		if profile == nil {
+			return status.Errorf(codes.InvalidArgument, "unknown profile %q, expected one of %s", req.Kind, strings.Join(profileKinds(), ", "))
+		}
+		if req.Seconds != 0 {
+			return status.Errorf(codes.InvalidArgument, "seconds only apply to the %s profile", cpuProfile)
+		}
+		if err := profile.WriteTo(&buf, 0); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	for buf.Len() > 0 {
+		if err := stream.Send(&pb.ProfileResponse{Data: buf.Next(profileChunkSize)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"github.com/MinterTeam/minter-node-cli/pb"
+	"github.com/golang/protobuf/ptypes/empty"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"time"
+)
+
+// processStart approximates the start of the node process for its uptime
+var processStart = time.Now()
+
+// Resources reports the memory, goroutines, open files and uptime of the node process,
+// the size of its databases and the space left on the volume of its data directory.
+// Values unavailable on the platform are -1.
+func (m *Manager) Resources(context.Context, *empty.Empty) (*pb.ResourcesResponse, error) {
+	var memStats runtime.MemStats
+	runtime.ReadMemStats(&memStats)
+
+	response := &pb.ResourcesResponse{
+		MemStats: &pb.ResourcesResponse_MemStats{
+			Alloc:       memStats.Alloc,
+			TotalAlloc:  memStats.TotalAlloc,
+			Sys:         memStats.Sys,
+			HeapAlloc:   memStats.HeapAlloc,
+			HeapInuse:   memStats.HeapInuse,
+			HeapObjects: memStats.HeapObjects,
+			StackInuse:  memStats.StackInuse,
+			NumGc:       memStats.NumGC,
+			PauseTotal:  int64(memStats.PauseTotalNs),
+		},
+		Goroutines: int64(runtime.NumGoroutine()),
+		OpenFds:    -1,
+		Uptime:     int64(time.Since(processStart)),
+		Databases:  m.databases(),
+		DataDir:    filepath.Join(m.cfg.RootDir, "data"),
+		DiskFree:   -1,
+		DiskTotal:  -1,
+	}
+	if memStats.LastGC > 0 {
+		response.MemStats.LastGc = time.Unix(0, int64(memStats.LastGC)).Format(time.RFC3339)
+	}
+	if fds, err := openFDs(); err == nil {
+		response.OpenFds = fds
+	}
+	if free, total, err := diskUsage(response.DataDir); err == nil {
+		response.DiskFree, response.DiskTotal = free, total
+	}
+
+	return response, nil
+}
+
+// databases returns the size of the Minter databases in the data directory
+// and of the Tendermint databases in db_dir, named by their path relative to the home directory
+func (m *Manager) databases() []*pb.ResourcesResponse_Database {
+	dirs := []string{filepath.Join(m.cfg.RootDir, "data")}
+	if dbDir := m.cfg.DBDir(); dbDir != dirs[0] {
+		dirs = append(dirs, dbDir)
+	}
+
+	var databases []*pb.ResourcesResponse_Database
+	for _, dir := range dirs {
+		paths, _ := filepath.Glob(filepath.Join(dir, "*.db"))
+		sort.Strings(paths)
+		for _, path := range paths {
+			if info, err := os.Stat(path); err != nil || !info.IsDir() {
+				continue
+			}
+			name, err := filepath.Rel(m.cfg.RootDir, path)
+			if err != nil {
+				name = path
+			}
+			databases = append(databases, &pb.ResourcesResponse_Database{Name: name, Size: dirSize(path)})
+		}
+	}
+	return databases
+}
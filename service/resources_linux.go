@@ -0,0 +1,23 @@
+package service
+
+import (
+	"io/ioutil"
+	"syscall"
+)
+
+func openFDs() (int64, error) {
+	fds, err := ioutil.ReadDir("/proc/self/fd")
+	if err != nil {
+		return 0, err
+	}
+	return int64(len(fds)), nil
+}
+
+// diskUsage returns the space available to the node and the size of the volume of the path
+func diskUsage(path string) (int64, int64, error) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(path, &stat); err != nil {
+		return 0, 0, err
+	}
+	return int64(stat.Bavail) * int64(stat.Bsize), int64(stat.Blocks) * int64(stat.Bsize), nil
+}
@@ -0,0 +1,14 @@
+//go:build !linux
+// +build !linux
+
+package service
+
+import "errors"
+
+func openFDs() (int64, error) {
+	return 0, errors.New("open file descriptors are not supported on this platform")
+}
+
+func diskUsage(path string) (int64, int64, error) {
+	return 0, 0, errors.New("disk usage is not supported on this platform")
+}
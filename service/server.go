@@ -2,13 +2,116 @@ package service
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
 	"github.com/MinterTeam/minter-node-cli/pb"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
 	"net"
+	"net/http"
 	"os"
+	"strings"
 )
 
-func StartCLIServer(socketPath string, manager pb.ManagerServiceServer, ctx context.Context) error {
+type serverOptions struct {
+	policy     *Policy
+	audit      *AuditLog
+	logLevels  *LogLevels
+	tlsAddress string
+	tlsConfig  *tls.Config
+
+	metricsAddress string
+	metrics        *managerMetrics
+
+	healthRules HealthRules
+
+	gatewayAddress string
+}
+
+// ServerOption configures the manager server started by StartCLIServer
+type ServerOption func(*serverOptions)
+
+// WithPolicy authorizes every call against the roles of the policy
+func WithPolicy(policy *Policy) ServerOption {
+	return func(o *serverOptions) {
+		o.policy = policy
+	}
+}
+
+// WithAuditLog records every mutating call in the audit log and serves it through the AuditLog method
+func WithAuditLog(audit *AuditLog) ServerOption {
+	return func(o *serverOptions) {
+		o.audit = audit
+	}
+}
+
+// WithLogLevels serves the runtime log levels of the node through GetLogLevel and SetLogLevel
+func WithLogLevels(levels *LogLevels) ServerOption {
+	return func(o *serverOptions) {
+		o.logLevels = levels
+	}
+}
+
+// WithTLSListener additionally serves the manager on the TCP address, which requires WithPolicy.
+// The config must require and verify client certificates (see NewServerTLSConfig).
+func WithTLSListener(address string, config *tls.Config) ServerOption {
+	return func(o *serverOptions) {
+		o.tlsAddress = address
+		o.tlsConfig = config
+	}
+}
+
+// WithHealthRules changes the rules under which the grpc.health.v1 service reports the node serving
+func WithHealthRules(rules HealthRules) ServerOption {
+	return func(o *serverOptions) {
+		o.healthRules = rules
+	}
+}
+
+// WithMetricsListener serves the Prometheus metrics of the manager at /metrics on the TCP address,
+// which should be local as the endpoint is not authorized
+func WithMetricsListener(address string) ServerOption {
+	return func(o *serverOptions) {
+		o.metricsAddress = address
+	}
+}
+
+// WithGatewayListener additionally serves the manager as HTTP/JSON (see pb/manager.yaml for the routes)
+// on the address, either a unix socket path prefixed with unix:// or a loopback host:port.
+// Callers are authorized by the policy as on the manager socket. Callers over TCP cannot be identified
+// and get the default role of the policy, so a TCP gateway requires WithPolicy.
+func WithGatewayListener(address string) ServerOption {
+	return func(o *serverOptions) {
+		o.gatewayAddress = address
+	}
+}
+
+func StartCLIServer(socketPath string, manager pb.ManagerServiceServer, ctx context.Context, opts ...ServerOption) error {
+	options := &serverOptions{healthRules: DefaultHealthRules}
+	for _, opt := range opts {
+		opt(options)
+	}
+	if err := options.validate(); err != nil {
+		return err
+	}
+
+	if options.logLevels != nil {
+		manager = logLevelsManager{ManagerServiceServer: manager, levels: options.logLevels}
+	}
+	if options.audit != nil {
+		manager = auditedManager{ManagerServiceServer: manager, audit: options.audit}
+	}
+
+	if options.metricsAddress != "" {
+		options.metrics = newMetrics(manager)
+		if options.audit != nil {
+			options.metrics.registerAudit(options.audit)
+		}
+	}
+
 	if err := os.RemoveAll(socketPath); err != nil {
 		return err
 	}
@@ -18,24 +121,194 @@ func StartCLIServer(socketPath string, manager pb.ManagerServiceServer, ctx cont
 		return err
 	}
 
-	server := grpc.NewServer()
+	var unixCreds credentials.TransportCredentials
+	if options.policy != nil {
+		unixCreds = peerCredentialsTransport{}
+	}
+	servers := []*grpc.Server{newManagerServer(manager, unixCreds, options, false)}
+	listeners := []net.Listener{lis}
 
-	pb.RegisterManagerServiceServer(server, manager)
+	if options.tlsAddress != "" {
+		tlsLis, err := net.Listen("tcp", options.tlsAddress)
+		if err != nil {
+			_ = lis.Close()
+			return err
+		}
+		servers = append(servers, newManagerServer(manager, credentials.NewTLS(options.tlsConfig), options, false))
+		listeners = append(listeners, tlsLis)
+	}
+
+	var (
+		httpServers   []*http.Server
+		httpListeners []net.Listener
+		gatewayConn   *grpc.ClientConn
+	)
+	closeListeners := func() {
+		for _, lis := range append(listeners, httpListeners...) {
+			_ = lis.Close()
+		}
+	}
+
+	if options.metrics != nil {
+		metricsLis, err := net.Listen("tcp", options.metricsAddress)
+		if err != nil {
+			closeListeners()
+			return err
+		}
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", options.metrics.handler())
+		httpServers = append(httpServers, &http.Server{Handler: mux})
+		httpListeners = append(httpListeners, metricsLis)
+	}
+
+	if options.gatewayAddress != "" {
+		gatewayLis, err := listenGateway(options.gatewayAddress)
+		if err != nil {
+			closeListeners()
+			return err
+		}
+		// the gateway calls its own server in memory, which authorizes the HTTP callers
+		internal := bufconn.Listen(gatewayBufferSize)
+		var gateway *http.Server
+		gateway, gatewayConn, err = newGateway(ctx, internal)
+		if err != nil {
+			_ = gatewayLis.Close()
+			closeListeners()
+			return err
+		}
+		servers = append(servers, newManagerServer(manager, nil, options, true))
+		listeners = append(listeners, internal)
+		httpServers = append(httpServers, gateway)
+		httpListeners = append(httpListeners, gatewayLis)
+	}
 
 	kill := make(chan struct{})
 	defer close(kill)
 	go func() {
 		select {
 		case <-ctx.Done():
-			server.GracefulStop()
 		case <-kill:
 		}
+		for _, server := range httpServers {
+			_ = server.Close()
+		}
+		if gatewayConn != nil {
+			_ = gatewayConn.Close()
+		}
+		for _, server := range servers {
+			server.GracefulStop()
+		}
 		return
 	}()
 
-	if err := server.Serve(lis); err != nil {
+	errs := make(chan error, len(servers)+len(httpServers))
+	for i := range servers {
+		go func(server *grpc.Server, lis net.Listener) {
+			errs <- server.Serve(lis)
+		}(servers[i], listeners[i])
+	}
+	for i := range httpServers {
+		go func(server *http.Server, lis net.Listener) {
+			if err := server.Serve(lis); err != http.ErrServerClosed {
+				errs <- err
+				return
+			}
+			errs <- nil
+		}(httpServers[i], httpListeners[i])
+	}
+
+	// the first listener to stop brings the others down as well
+	if err := <-errs; err != nil {
 		return err
 	}
 
 	return nil
 }
+
+// validate refuses the listeners which would let callers in without authorizing them
+func (o *serverOptions) validate() error {
+	if o.tlsAddress != "" {
+		if o.policy == nil {
+			return errors.New("the TLS listener requires a policy to authorize its callers")
+		}
+		if o.tlsConfig == nil || o.tlsConfig.ClientAuth != tls.RequireAndVerifyClientCert {
+			return errors.New("the TLS listener must require and verify client certificates")
+		}
+	}
+	if o.gatewayAddress != "" && !strings.HasPrefix(o.gatewayAddress, unixScheme) {
+		if !isLoopbackAddress(o.gatewayAddress) {
+			return fmt.Errorf("the gateway must listen on a unix socket or a loopback address, not %s", o.gatewayAddress)
+		}
+		if o.policy == nil {
+			return errors.New("the TCP gateway requires a policy, as its callers cannot be identified")
+		}
+	}
+	return nil
+}
+
+// newManagerServer serves the manager with the interceptors of the options,
+// a gateway server takes the identity of its callers from the metadata set by the gateway
+func newManagerServer(manager pb.ManagerServiceServer, creds credentials.TransportCredentials, options *serverOptions, gateway bool) *grpc.Server {
+	var (
+		serverOpts         []grpc.ServerOption
+		unaryInterceptors  []grpc.UnaryServerInterceptor
+		streamInterceptors []grpc.StreamServerInterceptor
+	)
+	if creds != nil {
+		serverOpts = append(serverOpts, grpc.Creds(creds))
+	}
+	if gateway {
+		unaryInterceptors = append(unaryInterceptors, gatewayUnaryInterceptor)
+		streamInterceptors = append(streamInterceptors, gatewayStreamInterceptor)
+	}
+	// denied calls are measured and audited too
+	if options.metrics != nil {
+		unaryInterceptors = append(unaryInterceptors, options.metrics.unaryInterceptor)
+		streamInterceptors = append(streamInterceptors, options.metrics.streamInterceptor)
+	}
+	if options.audit != nil {
+		unaryInterceptors = append(unaryInterceptors, options.audit.unaryInterceptor)
+		streamInterceptors = append(streamInterceptors, options.audit.streamInterceptor)
+	}
+	if options.policy != nil {
+		unaryInterceptors = append(unaryInterceptors, options.policy.unaryInterceptor)
+		streamInterceptors = append(streamInterceptors, options.policy.streamInterceptor)
+	}
+	serverOpts = append(serverOpts,
+		grpc.UnaryInterceptor(chainUnaryInterceptors(unaryInterceptors)),
+		grpc.StreamInterceptor(chainStreamInterceptors(streamInterceptors)),
+	)
+
+	server := grpc.NewServer(serverOpts...)
+	pb.RegisterManagerServiceServer(server, manager)
+	healthpb.RegisterHealthServer(server, healthServer{manager: manager, rules: options.healthRules})
+	return server
+}
+
+// chainUnaryInterceptors runs the interceptors in order, the first one being the outermost
+func chainUnaryInterceptors(interceptors []grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		chained := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, next := interceptors[i], chained
+			chained = func(ctx context.Context, req interface{}) (interface{}, error) {
+				return interceptor(ctx, req, info, next)
+			}
+		}
+		return chained(ctx, req)
+	}
+}
+
+// chainStreamInterceptors runs the interceptors in order, the first one being the outermost
+func chainStreamInterceptors(interceptors []grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		chained := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, next := interceptors[i], chained
+			chained = func(srv interface{}, ss grpc.ServerStream) error {
+				return interceptor(srv, ss, info, next)
+			}
+		}
+		return chained(srv, ss)
+	}
+}
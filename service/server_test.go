@@ -2,9 +2,6 @@ package service
 
 import (
 	"context"
-	"github.com/MinterTeam/minter-go-node/config"
-	"github.com/MinterTeam/minter-go-node/core/minter"
-	rpc "github.com/tendermint/tendermint/rpc/client"
 	"io/ioutil"
 	"path/filepath"
 	"testing"
@@ -12,28 +9,33 @@ import (
 )
 
 func TestStartCLIServer(t *testing.T) {
-	var (
-		blockchain *minter.Blockchain
-		tmRPC      *rpc.Local
-		cfg        *config.Config
-	)
+	b := newTestBackend(t)
 	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 	socketPath, _ := filepath.Abs(filepath.Join(".", "file.sock"))
 	_ = ioutil.WriteFile(socketPath, []byte("address already in use"), 0644)
+	errs := make(chan error, 1)
 	go func() {
-		err := StartCLIServer(socketPath, NewManager(blockchain, tmRPC, cfg), ctx)
-		if err != nil {
-			t.Log(err)
-		}
+		errs <- StartCLIServer(socketPath, NewManagerWith(b.blockchain, b.rpc, b.node, b.cfg), ctx)
 	}()
-	time.Sleep(time.Millisecond)
-	console, err := ConfigureManagerConsole(socketPath)
+	time.Sleep(10 * time.Millisecond)
+
+	console, err := ConfigureManagerConsole(socketPath, WithHistory("", 0, nil))
 	if err != nil {
-		t.Log(err)
+		t.Fatal(err)
 	}
-	err = console.Execute([]string{"test"})
-	if err != nil {
-		t.Log(err)
+	if err := console.Execute([]string{"status", "--output", "yaml"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := console.Execute([]string{"stop_peer", "--id", string(testPeerID)}); err != nil {
+		t.Fatal(err)
 	}
+	if len(b.node.stopped) != 1 {
+		t.Errorf("stopped %v", b.node.stopped)
+	}
+
 	cancel()
+	if err := <-errs; err != nil {
+		t.Fatal(err)
+	}
 }
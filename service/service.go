@@ -1,27 +1,64 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"encoding/hex"
 	"fmt"
 	"github.com/MinterTeam/minter-go-node/config"
 	"github.com/MinterTeam/minter-go-node/core/minter"
-	"github.com/MinterTeam/minter-go-node/version"
+	"github.com/MinterTeam/minter-go-node/core/state/candidates"
+	"github.com/MinterTeam/minter-go-node/core/state/validators"
+	minterTypes "github.com/MinterTeam/minter-go-node/core/types"
 	"github.com/MinterTeam/minter-node-cli/pb"
 	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmNode "github.com/tendermint/tendermint/node"
+	"github.com/tendermint/tendermint/p2p"
 	rpc "github.com/tendermint/tendermint/rpc/client"
+	tmState "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync/atomic"
 	"time"
 )
 
+// maxUnconfirmedTxs is the most txs Tendermint returns from the mempool at once
+const maxUnconfirmedTxs = 100
+
+// the last blocks checked for signatures of the validator by default and at most
+const (
+	defaultValidatorBlocks = 100
+	maxValidatorBlocks     = 10000
+)
+
+var candidateStatusNames = map[byte]string{
+	candidates.CandidateStatusOffline: "offline",
+	candidates.CandidateStatusOnline:  "online",
+}
+
+var watchStatusSubscribers uint64
+
 type Manager struct {
-	blockchain *minter.Blockchain
-	tmRPC      *rpc.Local
+	blockchain Blockchain
+	tmRPC      TendermintRPC
+	tmNode     Node
 	cfg        *config.Config
+	bans       *banList
+}
+
+func NewManager(blockchain *minter.Blockchain, tmRPC *rpc.Local, tmNode *tmNode.Node, cfg *config.Config) pb.ManagerServiceServer {
+	return NewManagerWith(blockchain, tmRPC, NewNode(tmNode), cfg)
 }
 
-func NewManager(blockchain *minter.Blockchain, tmRPC *rpc.Local, cfg *config.Config) pb.ManagerServiceServer {
-	return &Manager{blockchain: blockchain, tmRPC: tmRPC, cfg: cfg}
+// NewManagerWith creates the manager on top of any implementation of the node interfaces
+func NewManagerWith(blockchain Blockchain, tmRPC TendermintRPC, tmNode Node, cfg *config.Config) pb.ManagerServiceServer {
+	return &Manager{blockchain: blockchain, tmRPC: tmRPC, tmNode: tmNode, cfg: cfg, bans: newBanList()}
 }
 
 func (m *Manager) Status(context.Context, *empty.Empty) (*pb.StatusResponse, error) {
@@ -30,50 +67,64 @@ func (m *Manager) Status(context.Context, *empty.Empty) (*pb.StatusResponse, err
 		return new(pb.StatusResponse), status.Error(codes.Internal, err.Error())
 	}
 
-	response := &pb.StatusResponse{
-		Version:           version.Version,
-		LatestBlockHash:   fmt.Sprintf("%X", resultStatus.SyncInfo.LatestBlockHash),
-		LatestAppHash:     fmt.Sprintf("%X", resultStatus.SyncInfo.LatestAppHash),
-		LatestBlockHeight: resultStatus.SyncInfo.LatestBlockHeight,
-		LatestBlockTime:   resultStatus.SyncInfo.LatestBlockTime.Format(time.RFC3339),
-		KeepLastStates:    m.cfg.KeepLastStates,
-		TmStatus: &pb.StatusResponse_TmStatus{
-			NodeInfo: &pb.NodeInfo{
-				ProtocolVersion: &pb.NodeInfo_ProtocolVersion{
-					P2P:   uint64(resultStatus.NodeInfo.ProtocolVersion.P2P),
-					Block: uint64(resultStatus.NodeInfo.ProtocolVersion.Block),
-					App:   uint64(resultStatus.NodeInfo.ProtocolVersion.App),
-				},
-				Id:         fmt.Sprintf("%X", resultStatus.NodeInfo.ID_),
-				ListenAddr: resultStatus.NodeInfo.ListenAddr,
-				Network:    resultStatus.NodeInfo.Network,
-				Version:    resultStatus.NodeInfo.Version,
-				Channels:   fmt.Sprintf("%X", resultStatus.NodeInfo.Channels),
-				Moniker:    resultStatus.NodeInfo.Moniker,
-				Other: &pb.NodeInfo_Other{
-					TxIndex:    resultStatus.NodeInfo.Other.TxIndex,
-					RpcAddress: resultStatus.NodeInfo.Other.RPCAddress,
-				},
-			},
-			SyncInfo: &pb.StatusResponse_TmStatus_SyncInfo{
-				LatestBlockHash:   fmt.Sprintf("%X", resultStatus.SyncInfo.LatestBlockHash),
-				LatestAppHash:     fmt.Sprintf("%X", resultStatus.SyncInfo.LatestAppHash),
-				LatestBlockHeight: resultStatus.SyncInfo.LatestBlockHeight,
-				LatestBlockTime:   resultStatus.SyncInfo.LatestBlockTime.Format(time.RFC3339),
-				CatchingUp:        resultStatus.SyncInfo.CatchingUp,
-			},
-			ValidatorInfo: &pb.StatusResponse_TmStatus_ValidatorInfo{
-				Address: fmt.Sprintf("%X", resultStatus.ValidatorInfo.Address),
-				PubKey: &pb.StatusResponse_TmStatus_ValidatorInfo_PubKey{
-					Type:  "", //todo
-					Value: fmt.Sprintf("%X", resultStatus.ValidatorInfo.PubKey.Bytes()),
-				},
-				VotingPower: resultStatus.ValidatorInfo.VotingPower,
-			},
-		},
+	return convertStatus(resultStatus, m.cfg.KeepLastStates), nil
+}
+
+// WatchStatus sends the current status and then pushes it on every new block,
+// or every req.Interval nanoseconds if the interval is set
+func (m *Manager) WatchStatus(req *pb.WatchStatusRequest, stream pb.ManagerService_WatchStatusServer) error {
+	if req.Interval < 0 {
+		return status.Errorf(codes.InvalidArgument, "invalid interval: %d", req.Interval)
 	}
 
-	return response, nil
+	ctx := stream.Context()
+	send := func() error {
+		response, err := m.Status(ctx, &empty.Empty{})
+		if err != nil {
+			return err
+		}
+		return stream.Send(response)
+	}
+
+	if err := send(); err != nil {
+		return err
+	}
+
+	if req.Interval > 0 {
+		ticker := time.NewTicker(time.Duration(req.Interval))
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return status.FromContextError(ctx.Err()).Err()
+			case <-ticker.C:
+				if err := send(); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	subscriber := fmt.Sprintf("manager-watch-status-%d", atomic.AddUint64(&watchStatusSubscribers, 1))
+	blocks, err := m.tmRPC.Subscribe(ctx, subscriber, types.EventQueryNewBlock.String())
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = m.tmRPC.UnsubscribeAll(context.Background(), subscriber) }()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case _, ok := <-blocks:
+			if !ok {
+				return status.Error(codes.Unavailable, "new block subscription closed")
+			}
+			if err := send(); err != nil {
+				return err
+			}
+		}
+	}
 }
 
 func (m *Manager) NetInfo(context.Context, *empty.Empty) (*pb.NetInfoResponse, error) {
@@ -82,94 +133,541 @@ func (m *Manager) NetInfo(context.Context, *empty.Empty) (*pb.NetInfoResponse, e
 		return new(pb.NetInfoResponse), status.Error(codes.Internal, err.Error())
 	}
 
-	peers := make([]*pb.NetInfoResponse_Peer, 0, len(resultNetInfo.Peers))
-	for _, peer := range resultNetInfo.Peers {
-		channels := make([]*pb.NetInfoResponse_Peer_ConnectionStatus_Channel, 0, len(peer.ConnectionStatus.Channels))
-		for _, channel := range peer.ConnectionStatus.Channels {
-			channels = append(channels, &pb.NetInfoResponse_Peer_ConnectionStatus_Channel{
-				ID:                int32(channel.ID),
-				SendQueueCapacity: int64(channel.SendQueueCapacity),
-				SendQueueSize:     int64(channel.SendQueueSize),
-				Priority:          int64(channel.Priority),
-				RecentlySent:      channel.RecentlySent,
-			})
-		}
-		peers = append(peers, &pb.NetInfoResponse_Peer{
-			NodeInfo: &pb.NodeInfo{
-				ProtocolVersion: &pb.NodeInfo_ProtocolVersion{
-					P2P:   uint64(peer.NodeInfo.ProtocolVersion.P2P),
-					Block: uint64(peer.NodeInfo.ProtocolVersion.Block),
-					App:   uint64(peer.NodeInfo.ProtocolVersion.App),
-				},
-				Id:         string(peer.NodeInfo.ID_),
-				ListenAddr: peer.NodeInfo.ListenAddr,
-				Network:    peer.NodeInfo.Network,
-				Version:    peer.NodeInfo.Version,
-				Channels:   string(peer.NodeInfo.Channels),
-				Moniker:    peer.NodeInfo.Moniker,
-				Other: &pb.NodeInfo_Other{
-					TxIndex:    peer.NodeInfo.Other.TxIndex,
-					RpcAddress: peer.NodeInfo.Other.RPCAddress,
-				},
-			},
-			IsOutbound: peer.IsOutbound,
-			ConnectionStatus: &pb.NetInfoResponse_Peer_ConnectionStatus{
-				Duration: int64(peer.ConnectionStatus.Duration),
-				SendMonitor: &pb.NetInfoResponse_Peer_ConnectionStatus_Monitor{
-					Active:   peer.ConnectionStatus.SendMonitor.Active,
-					Start:    peer.ConnectionStatus.SendMonitor.Start.Format(time.RFC3339),
-					Duration: int64(peer.ConnectionStatus.SendMonitor.Duration),
-					Idle:     int64(peer.ConnectionStatus.SendMonitor.Idle),
-					Bytes:    peer.ConnectionStatus.SendMonitor.Bytes,
-					Samples:  peer.ConnectionStatus.SendMonitor.Samples,
-					InstRate: peer.ConnectionStatus.SendMonitor.InstRate,
-					CurRate:  peer.ConnectionStatus.SendMonitor.CurRate,
-					AvgRate:  peer.ConnectionStatus.SendMonitor.AvgRate,
-					PeakRate: peer.ConnectionStatus.SendMonitor.PeakRate,
-					BytesRem: peer.ConnectionStatus.SendMonitor.BytesRem,
-					TimeRem:  int64(peer.ConnectionStatus.SendMonitor.TimeRem),
-					Progress: uint32(peer.ConnectionStatus.SendMonitor.Progress),
-				},
-				RecvMonitor: &pb.NetInfoResponse_Peer_ConnectionStatus_Monitor{
-					Active:   peer.ConnectionStatus.RecvMonitor.Active,
-					Start:    peer.ConnectionStatus.RecvMonitor.Start.Format(time.RFC3339),
-					Duration: int64(peer.ConnectionStatus.RecvMonitor.Duration),
-					Idle:     int64(peer.ConnectionStatus.RecvMonitor.Idle),
-					Bytes:    peer.ConnectionStatus.RecvMonitor.Bytes,
-					Samples:  peer.ConnectionStatus.RecvMonitor.Samples,
-					InstRate: peer.ConnectionStatus.RecvMonitor.InstRate,
-					CurRate:  peer.ConnectionStatus.RecvMonitor.CurRate,
-					AvgRate:  peer.ConnectionStatus.RecvMonitor.AvgRate,
-					PeakRate: peer.ConnectionStatus.RecvMonitor.PeakRate,
-					BytesRem: peer.ConnectionStatus.RecvMonitor.BytesRem,
-					TimeRem:  int64(peer.ConnectionStatus.RecvMonitor.TimeRem),
-					Progress: uint32(peer.ConnectionStatus.RecvMonitor.Progress),
-				},
-				Channels: channels,
-			},
-			RemoteIp: peer.RemoteIP,
-		})
+	return convertNetInfo(resultNetInfo), nil
+}
+
+func (m *Manager) PruneBlocks(ctx context.Context, req *pb.PruneBlocksRequest) (*empty.Empty, error) {
+	res := new(empty.Empty)
+
+	pruner, err := m.pruner()
+	if err != nil {
+		return res, err
+	}
+	from, to, err := m.pruneRange(req.FromHeight, req.ToHeight)
+	if err != nil {
+		return res, err
 	}
 
-	response := &pb.NetInfoResponse{
-		Listening: resultNetInfo.Listening,
-		Listeners: resultNetInfo.Listeners,
-		NPeers:    int64(resultNetInfo.NPeers),
-		Peers:     peers,
+	// one height at a time, so that the node does not wait long for its commit lock
+	for height := from; height <= to; height++ {
+		if err := ctx.Err(); err != nil {
+			return res, status.FromContextError(err).Err()
+		}
+		if err := pruner.PruneBlocks(height, height); err != nil {
+			return res, status.Error(codes.Internal, err.Error())
+		}
 	}
 
-	return response, nil
+	return res, nil
 }
 
-func (m *Manager) PruneBlocks(ctx context.Context, req *pb.PruneBlocksRequest) (*empty.Empty, error) {
-	return new(empty.Empty), status.Error(codes.Unimplemented, "todo")
+// pruneSizeInterval is how often PruneBlocksStream measures the databases for the reclaimed bytes
+const pruneSizeInterval = 5 * time.Second
+
+func (m *Manager) PruneBlocksStream(req *pb.PruneBlocksRequest, stream pb.ManagerService_PruneBlocksStreamServer) error {
+	pruner, err := m.pruner()
+	if err != nil {
+		return err
+	}
+	from, to, err := m.pruneRange(req.FromHeight, req.ToHeight)
+	if err != nil {
+		return err
+	}
+
+	sizeBefore := m.prunedSize()
+	reclaimed, measured := int64(0), time.Now()
+	total := to - from + 1
+	start := time.Now()
+	for height := from; height <= to; height++ {
+		if err := stream.Context().Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+
+		if err := pruner.PruneBlocks(height, height); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		// walking the databases is slow, their size is only measured from time to time and at the end
+		if height == to || time.Since(measured) >= pruneSizeInterval {
+			reclaimed, measured = sizeBefore-m.prunedSize(), time.Now()
+		}
+
+		current := height - from + 1
+		elapsed := time.Since(start)
+		if err := stream.Send(&pb.PruneBlocksResponse{
+			Total:          total,
+			Current:        current,
+			Height:         height,
+			BytesReclaimed: reclaimed,
+			Eta:            int64(elapsed / time.Duration(current) * time.Duration(total-current)),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// pruner returns the blockchain as a BlockPruner, pruning is refused with blockchains which cannot
+// prune under their commit lock
+func (m *Manager) pruner() (BlockPruner, error) {
+	pruner, ok := m.blockchain.(BlockPruner)
+	if !ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "the blockchain (%T) cannot prune blocks under its commit lock", m.blockchain)
+	}
+	return pruner, nil
+}
+
+// prunedSize is the size of the state and block store databases
+func (m *Manager) prunedSize() int64 {
+	return dirSize(filepath.Join(m.cfg.RootDir, "data", "state.db")) + dirSize(filepath.Join(m.cfg.DBDir(), "blockstore.db"))
+}
+
+// dirSize returns the total size of regular files under the path, ignoring files that disappear during the walk
+func dirSize(path string) int64 {
+	var size int64
+	_ = filepath.Walk(path, func(_ string, info os.FileInfo, err error) error {
+		if err == nil && info.Mode().IsRegular() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size
+}
+
+// pruneRange validates the requested heights against the current height and returns the range of heights to prune.
+// The node only stores the last keep_last_states state versions, older heights only have their blocks left.
+func (m *Manager) pruneRange(fromHeight, toHeight int64) (int64, int64, error) {
+	if fromHeight < 1 || fromHeight > toHeight {
+		return 0, 0, status.Errorf(codes.InvalidArgument, "invalid range: from %d to %d", fromHeight, toHeight)
+	}
+
+	current := int64(m.blockchain.Height())
+	if toHeight >= current {
+		return 0, 0, status.Errorf(codes.FailedPrecondition, "cannot delete latest saved version (%d)", current)
+	}
+
+	return fromHeight, toHeight, nil
 }
 
 func (m *Manager) DealPeer(ctx context.Context, req *pb.DealPeerRequest) (*empty.Empty, error) {
 	res := new(empty.Empty)
-	_, err := m.tmRPC.DialPeers([]string{req.Address}, req.Persistent)
+	address, err := p2p.NewNetAddressString(req.Address)
 	if err != nil {
+		return res, status.Errorf(codes.InvalidArgument, "invalid address %q: %s", req.Address, err)
+	}
+	if m.bans.isBanned(address.ID) {
+		return res, status.Errorf(codes.FailedPrecondition, "peer %s is banned", address.ID)
+	}
+	if _, err := m.tmRPC.DialPeers([]string{req.Address}, req.Persistent); err != nil {
 		return res, status.Error(codes.FailedPrecondition, err.Error())
 	}
+	if req.Persistent {
+		m.bans.addPersistent(address.ID)
+	}
+	return res, nil
+}
+
+func (m *Manager) StopPeer(ctx context.Context, req *pb.StopPeerRequest) (*empty.Empty, error) {
+	res := new(empty.Empty)
+	id, err := parsePeerID(req.Id)
+	if err != nil {
+		return res, err
+	}
+
+	peer := m.tmNode.Peers().Get(id)
+	if peer == nil {
+		return res, status.Errorf(codes.NotFound, "peer %s is not connected", id)
+	}
+	m.tmNode.StopPeerGracefully(peer)
+
+	return res, nil
+}
+
+func (m *Manager) BanPeer(ctx context.Context, req *pb.BanPeerRequest) (*empty.Empty, error) {
+	res := new(empty.Empty)
+	id, err := parsePeerID(req.Id)
+	if err != nil {
+		return res, err
+	}
+	if req.Duration <= 0 {
+		return res, status.Errorf(codes.InvalidArgument, "invalid ban duration: %d", req.Duration)
+	}
+
+	// the switch redials persistent peers whatever the ban, disconnecting them again and again
+	if m.isPersistentPeer(id) {
+		return res, status.Errorf(codes.FailedPrecondition, "peer %s is a persistent peer of the node, remove it from persistent_peers instead", id)
+	}
+
+	m.bans.ban(id, time.Now().Add(time.Duration(req.Duration)))
+
+	if peer := m.tmNode.Peers().Get(id); peer != nil {
+		m.tmNode.StopPeerGracefully(peer)
+	}
+	book, _ := m.addressBook()
+	if book != nil {
+		book.MarkBad(&p2p.NetAddress{ID: id})
+	}
+	m.bans.enforce(m.tmNode, book, time.Second)
+
+	return res, nil
+}
+
+// isPersistentPeer tells whether the peer is in persistent_peers, connected as persistent
+// or dialed as persistent through the manager
+func (m *Manager) isPersistentPeer(id p2p.ID) bool {
+	if m.bans.isPersistent(id) {
+		return true
+	}
+	if peer := m.tmNode.Peers().Get(id); peer != nil && peer.IsPersistent() {
+		return true
+	}
+	for _, address := range strings.Split(m.cfg.P2P.PersistentPeers, ",") {
+		if netAddress, err := p2p.NewNetAddressString(strings.TrimSpace(address)); err == nil && netAddress.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *Manager) UnbanPeer(ctx context.Context, req *pb.UnbanPeerRequest) (*empty.Empty, error) {
+	res := new(empty.Empty)
+	id, err := parsePeerID(req.Id)
+	if err != nil {
+		return res, err
+	}
+	if !m.bans.unban(id) {
+		return res, status.Errorf(codes.NotFound, "peer %s is not banned", id)
+	}
 	return res, nil
 }
+
+func (m *Manager) ListBannedPeers(context.Context, *empty.Empty) (*pb.BannedPeersResponse, error) {
+	bans := m.bans.list()
+	peers := make([]*pb.BannedPeersResponse_Peer, 0, len(bans))
+	for id, until := range bans {
+		peers = append(peers, &pb.BannedPeersResponse_Peer{
+			Id:    string(id),
+			Until: until.Format(time.RFC3339),
+		})
+	}
+	sort.Slice(peers, func(i, j int) bool { return peers[i].Id < peers[j].Id })
+
+	return &pb.BannedPeersResponse{Peers: peers}, nil
+}
+
+func (m *Manager) AuditLog(context.Context, *pb.AuditLogRequest) (*pb.AuditLogResponse, error) {
+	return new(pb.AuditLogResponse), status.Error(codes.FailedPrecondition, "audit log is not enabled")
+}
+
+func (m *Manager) GetLogLevel(context.Context, *empty.Empty) (*pb.LogLevelResponse, error) {
+	return new(pb.LogLevelResponse), status.Error(codes.FailedPrecondition, "runtime log levels are not enabled")
+}
+
+func (m *Manager) SetLogLevel(context.Context, *pb.SetLogLevelRequest) (*pb.LogLevelResponse, error) {
+	return new(pb.LogLevelResponse), status.Error(codes.FailedPrecondition, "runtime log levels are not enabled")
+}
+
+func (m *Manager) UnconfirmedTxs(ctx context.Context, req *pb.UnconfirmedTxsRequest) (*pb.UnconfirmedTxsResponse, error) {
+	if req.Limit < 0 {
+		return new(pb.UnconfirmedTxsResponse), status.Errorf(codes.InvalidArgument, "invalid limit: %d", req.Limit)
+	}
+
+	// filtering by sender needs as many txs as Tendermint returns at once,
+	// it cannot page through the mempool, so the filter misses the txs beyond them
+	limit := int(req.Limit)
+	if req.Sender != "" {
+		limit = maxUnconfirmedTxs
+	}
+	resultUnconfirmedTxs, err := m.tmRPC.UnconfirmedTxs(limit)
+	if err != nil {
+		return new(pb.UnconfirmedTxsResponse), status.Error(codes.Internal, err.Error())
+	}
+
+	txs := make([]*pb.Transaction, 0, len(resultUnconfirmedTxs.Txs))
+	for _, rawTx := range resultUnconfirmedTxs.Txs {
+		tx := decodeTransaction(rawTx)
+		if req.Sender != "" && !strings.EqualFold(tx.From, req.Sender) {
+			continue
+		}
+		if req.Limit > 0 && int64(len(txs)) == req.Limit {
+			break
+		}
+		txs = append(txs, tx)
+	}
+
+	return &pb.UnconfirmedTxsResponse{
+		NTxs:       int64(len(txs)),
+		Total:      int64(resultUnconfirmedTxs.Total),
+		TotalBytes: resultUnconfirmedTxs.TotalBytes,
+		Txs:        txs,
+		Truncated:  req.Sender != "" && resultUnconfirmedTxs.Count < resultUnconfirmedTxs.Total,
+	}, nil
+}
+
+func (m *Manager) NumUnconfirmedTxs(context.Context, *empty.Empty) (*pb.NumUnconfirmedTxsResponse, error) {
+	resultUnconfirmedTxs, err := m.tmRPC.NumUnconfirmedTxs()
+	if err != nil {
+		return new(pb.NumUnconfirmedTxsResponse), status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.NumUnconfirmedTxsResponse{
+		NTxs:       int64(resultUnconfirmedTxs.Count),
+		Total:      int64(resultUnconfirmedTxs.Total),
+		TotalBytes: resultUnconfirmedTxs.TotalBytes,
+	}, nil
+}
+
+func (m *Manager) FlushMempool(context.Context, *empty.Empty) (*empty.Empty, error) {
+	m.tmNode.FlushMempool()
+	return new(empty.Empty), nil
+}
+
+func (m *Manager) Block(ctx context.Context, req *pb.BlockRequest) (*pb.BlockResponse, error) {
+	height, err := m.blockHeight(req.Height)
+	if err != nil {
+		return new(pb.BlockResponse), err
+	}
+
+	resultBlock, err := m.tmRPC.Block(&height)
+	if err != nil {
+		return new(pb.BlockResponse), status.Error(codes.Internal, err.Error())
+	}
+	if resultBlock.Block == nil {
+		return new(pb.BlockResponse), status.Errorf(codes.NotFound, "block %d is not stored", height)
+	}
+	block := resultBlock.Block
+
+	txs := make([]*pb.Transaction, 0, len(block.Txs))
+	for _, rawTx := range block.Txs {
+		txs = append(txs, decodeTransaction(rawTx))
+	}
+
+	signatures, err := m.commitSignatures(height)
+	if err != nil {
+		return new(pb.BlockResponse), err
+	}
+	var missing int64
+	for _, signature := range signatures {
+		if !signature.Signed {
+			missing++
+		}
+	}
+
+	return &pb.BlockResponse{
+		Hash:              fmt.Sprintf("%X", resultBlock.BlockMeta.BlockID.Hash),
+		Height:            block.Height,
+		Time:              block.Time.Format(time.RFC3339Nano),
+		NumTxs:            block.NumTxs,
+		Proposer:          fmt.Sprintf("%X", block.ProposerAddress),
+		Transactions:      txs,
+		Signatures:        signatures,
+		MissingSignatures: missing,
+	}, nil
+}
+
+// commitSignatures lists every validator of the height with its precommit for the block, if any
+func (m *Manager) commitSignatures(height int64) ([]*pb.BlockResponse_Signature, error) {
+	resultCommit, err := m.tmRPC.Commit(&height)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resultValidators, err := m.tmRPC.Validators(&height)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	precommits := resultCommit.Commit.Precommits
+	signatures := make([]*pb.BlockResponse_Signature, 0, len(resultValidators.Validators))
+	for i, validator := range resultValidators.Validators {
+		signature := &pb.BlockResponse_Signature{
+			ValidatorAddress: fmt.Sprintf("%X", validator.Address),
+			VotingPower:      validator.VotingPower,
+		}
+		if signedBlock(resultCommit.Commit, i) {
+			signature.Signed = true
+			signature.Signature = fmt.Sprintf("%X", precommits[i].Signature)
+		}
+		signatures = append(signatures, signature)
+	}
+
+	return signatures, nil
+}
+
+// signedBlock tells whether the precommit at the validator index is a vote for the committed block,
+// validators which precommitted nil did not sign it
+func signedBlock(commit *types.Commit, index int) bool {
+	if index < 0 || index >= len(commit.Precommits) {
+		return false
+	}
+	precommit := commit.Precommits[index]
+	return precommit != nil && precommit.BlockID.Equals(commit.BlockID)
+}
+
+func (m *Manager) BlockResults(ctx context.Context, req *pb.BlockRequest) (*pb.BlockResultsResponse, error) {
+	height, err := m.blockHeight(req.Height)
+	if err != nil {
+		return new(pb.BlockResultsResponse), err
+	}
+
+	resultBlockResults, err := m.tmRPC.BlockResults(&height)
+	if _, ok := err.(tmState.ErrNoABCIResponsesForHeight); ok {
+		return new(pb.BlockResultsResponse), status.Errorf(codes.NotFound, "results of block %d are not stored", height)
+	}
+	if err != nil {
+		return new(pb.BlockResultsResponse), status.Error(codes.Internal, err.Error())
+	}
+	results := resultBlockResults.Results
+
+	response := &pb.BlockResultsResponse{
+		Height:    resultBlockResults.Height,
+		DeliverTx: make([]*pb.TxResult, 0, len(results.DeliverTx)),
+	}
+	for _, deliverTx := range results.DeliverTx {
+		response.DeliverTx = append(response.DeliverTx, convertTxResult(deliverTx))
+	}
+	if results.BeginBlock != nil {
+		response.BeginBlockEvents = convertEvents(results.BeginBlock.Events)
+	}
+	if results.EndBlock != nil {
+		response.EndBlockEvents = convertEvents(results.EndBlock.Events)
+	}
+	for _, event := range m.blockchain.GetEventsDB().LoadEvents(uint32(height)) {
+		minterEvent, err := convertMinterEvent(event)
+		if err != nil {
+			return new(pb.BlockResultsResponse), status.Error(codes.Internal, err.Error())
+		}
+		response.MinterEvents = append(response.MinterEvents, minterEvent)
+	}
+
+	return response, nil
+}
+
+// blockHeight resolves the requested height, 0 being the latest block
+func (m *Manager) blockHeight(height int64) (int64, error) {
+	if height < 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid height: %d", height)
+	}
+	latest := m.tmNode.BlockStoreHeight()
+	if height == 0 {
+		return latest, nil
+	}
+	if height > latest {
+		return 0, status.Errorf(codes.NotFound, "block %d is not committed yet, the latest is %d", height, latest)
+	}
+	if !m.tmNode.BlockStored(height) {
+		return 0, status.Errorf(codes.NotFound, "block %d is pruned", height)
+	}
+	return height, nil
+}
+
+func (m *Manager) Tx(ctx context.Context, req *pb.TxRequest) (*pb.TxResponse, error) {
+	hash, err := parseTxHash(req.Hash)
+	if err != nil {
+		return new(pb.TxResponse), err
+	}
+
+	resultTxSearch, err := m.tmRPC.TxSearch(fmt.Sprintf("tx.hash='%X'", hash), false, 1, 1)
+	if err != nil {
+		return new(pb.TxResponse), status.Error(codes.Internal, err.Error())
+	}
+	if len(resultTxSearch.Txs) == 0 || resultTxSearch.Txs[0] == nil {
+		return new(pb.TxResponse), status.Errorf(codes.NotFound, "transaction Mt%x is not found", hash)
+	}
+	resultTx := resultTxSearch.Txs[0]
+
+	return &pb.TxResponse{
+		Height:      resultTx.Height,
+		Index:       resultTx.Index,
+		Transaction: decodeTransaction(resultTx.Tx),
+		Result:      convertTxResult(&resultTx.TxResult),
+	}, nil
+}
+
+func (m *Manager) Validator(ctx context.Context, req *pb.ValidatorRequest) (*pb.ValidatorResponse, error) {
+	if req.Blocks < 0 || req.Blocks > maxValidatorBlocks {
+		return new(pb.ValidatorResponse), status.Errorf(codes.InvalidArgument, "blocks must be between 0 and %d", maxValidatorBlocks)
+	}
+	blocks := req.Blocks
+	if blocks == 0 {
+		blocks = defaultValidatorBlocks
+	}
+
+	resultStatus, err := m.tmRPC.Status()
+	if err != nil {
+		return new(pb.ValidatorResponse), status.Error(codes.Internal, err.Error())
+	}
+	tmPubKey, ok := resultStatus.ValidatorInfo.PubKey.(ed25519.PubKeyEd25519)
+	if !ok {
+		return new(pb.ValidatorResponse), status.Errorf(codes.FailedPrecondition, "unsupported validator key type %T", resultStatus.ValidatorInfo.PubKey)
+	}
+	pubKey := minterTypes.Pubkey(tmPubKey)
+	address := resultStatus.ValidatorInfo.Address
+
+	response := &pb.ValidatorResponse{
+		PubKey:             pubKey.String(),
+		Address:            fmt.Sprintf("%X", address),
+		Active:             resultStatus.ValidatorInfo.VotingPower > 0,
+		VotingPower:        resultStatus.ValidatorInfo.VotingPower,
+		MaxMissedBlocks:    validators.ValidatorMaxAbsentTimes,
+		MissedBlocksWindow: validators.ValidatorMaxAbsentWindow,
+	}
+
+	state := m.blockchain.CurrentState()
+	if candidate := state.Candidates.GetCandidate(pubKey); candidate != nil {
+		response.Candidate = true
+		response.CandidateStatus = candidateStatusNames[candidate.Status]
+		response.TotalStake = state.Candidates.GetTotalStake(pubKey).String()
+		response.Commission = uint32(candidate.Commission)
+		response.OwnerAddress = candidate.OwnerAddress.String()
+		response.RewardAddress = candidate.RewardAddress.String()
+	}
+	for _, validator := range state.Validators.GetValidators() {
+		if validator.PubKey == pubKey {
+			response.MissedBlocks = int64(validator.CountAbsentTimes())
+			break
+		}
+	}
+
+	latest := resultStatus.SyncInfo.LatestBlockHeight
+	for height := latest; height > latest-blocks && height > 0; height-- {
+		if err := ctx.Err(); err != nil {
+			return new(pb.ValidatorResponse), status.FromContextError(err).Err()
+		}
+		if !m.tmNode.BlockStored(height) {
+			// the blocks below are pruned too, Tendermint cannot load their commits
+			break
+		}
+		h := height
+		resultCommit, err := m.tmRPC.Commit(&h)
+		if err != nil {
+			return new(pb.ValidatorResponse), status.Error(codes.Internal, err.Error())
+		}
+		// precommits are in the order of the validator set of the height
+		resultValidators, err := m.tmRPC.Validators(&h)
+		if err != nil {
+			return new(pb.ValidatorResponse), status.Error(codes.Internal, err.Error())
+		}
+		response.Blocks++
+		for i, validator := range resultValidators.Validators {
+			if bytes.Equal(validator.Address, address) {
+				if signedBlock(resultCommit.Commit, i) {
+					response.SignedBlocks++
+				}
+				break
+			}
+		}
+	}
+
+	return response, nil
+}
+
+// parsePeerID validates a hex-encoded node ID
+func parsePeerID(id string) (p2p.ID, error) {
+	id = strings.ToLower(id)
+	bytes, err := hex.DecodeString(id)
+	if err != nil || len(bytes) != p2p.IDByteLength {
+		return "", status.Errorf(codes.InvalidArgument, "invalid peer id: %q", id)
+	}
+	return p2p.ID(id), nil
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// DefaultTimeout bounds the manager calls of the console commands unless changed with WithTimeout or --timeout
+const DefaultTimeout = 30 * time.Second
+
+func timeoutFlag(value time.Duration) *cli.DurationFlag {
+	return &cli.DurationFlag{Name: "timeout", Required: false, Value: value, Usage: "deadline of the call to the node, 0 for none"}
+}
+
+// callContext returns the context of a manager call, cancelled on Ctrl-C and after the timeout
+// of the command flags, falling back to the global --timeout of the console and then to the default
+func callContext(c *cli.Context, defaultTimeout time.Duration) (context.Context, context.CancelFunc) {
+	timeout := defaultTimeout
+	for _, ctx := range c.Lineage() {
+		if ctx.IsSet("timeout") {
+			timeout = ctx.Duration("timeout")
+			break
+		}
+	}
+
+	ctx, cancel := contextWithInterrupt(context.Background())
+	if timeout <= 0 {
+		return ctx, cancel
+	}
+	ctx, cancelTimeout := context.WithTimeout(ctx, timeout)
+	return ctx, func() {
+		cancelTimeout()
+		cancel()
+	}
+}
+
+// callError is a failed manager call described for the console, keeping the status of the call
+type callError struct {
+	message string
+	err     error
+}
+
+func (e *callError) Error() string {
+	return e.message
+}
+
+func (e *callError) Unwrap() error {
+	return e.err
+}
+
+// describeError replaces the errors of calls which timed out, were cancelled or could not reach the node
+// by messages telling what happened, other errors are returned as they are
+func describeError(err error) error {
+	s, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	switch s.Code() {
+	case codes.DeadlineExceeded:
+		return &callError{message: "the node did not answer in time, retry with a longer --timeout", err: err}
+	case codes.Unavailable:
+		return &callError{message: fmt.Sprintf("the node is unavailable, check that it is running and its manager is reachable (%s)", s.Message()), err: err}
+	case codes.Canceled:
+		return &callError{message: "cancelled", err: err}
+	}
+	return err
+}
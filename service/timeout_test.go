@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/MinterTeam/minter-node-cli/pb"
+	"github.com/golang/protobuf/ptypes/empty"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// hungManager never answers Status
+type hungManager struct {
+	pb.ManagerServiceServer
+}
+
+func (hungManager) Status(ctx context.Context, _ *empty.Empty) (*pb.StatusResponse, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestTimeout(t *testing.T) {
+	dir, err := ioutil.TempDir("", "timeout")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	b := newTestBackend(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	socketPath := filepath.Join(dir, "manager.sock")
+	go func() {
+		_ = StartCLIServer(socketPath, hungManager{NewManagerWith(b.blockchain, b.rpc, b.node, b.cfg)}, ctx)
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	console, err := ConfigureManagerConsole(socketPath, WithTimeout(0), WithHistory(filepath.Join(dir, "history"), DefaultHistoryLimit, DefaultSecretPatterns))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, args := range [][]string{
+		{"--timeout", "50ms", "status"},
+		{"status", "--timeout", "50ms"},
+		{"--timeout", "1h", "status", "--timeout", "50ms"},
+	} {
+		err := console.Execute(args)
+		if err == nil || !strings.Contains(err.Error(), "did not answer in time") {
+			t.Errorf("%v: error = %v", args, err)
+		}
+		if code := ExitCode(err); code != ExitTimeout {
+			t.Errorf("%v: ExitCode() = %d", args, code)
+		}
+	}
+	if err := console.Execute([]string{"net_info", "--timeout", "1s"}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestExitCode(t *testing.T) {
+	console, err := ConfigureManagerConsole(filepath.Join(os.TempDir(), "missing-manager.sock"), WithHistory("", 0, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = console.ExecuteBatch(strings.NewReader("status\n"), false)
+	if err == nil || !strings.Contains(err.Error(), "the node is unavailable") {
+		t.Errorf("ExecuteBatch() = %v", err)
+	}
+	if code := ExitCode(err); code != ExitUnavailable {
+		t.Errorf("ExitCode() = %d, want %d", code, ExitUnavailable)
+	}
+
+	if code := ExitCode(errors.New("aborted")); code != ExitFailure {
+		t.Errorf("ExitCode() = %d, want %d", code, ExitFailure)
+	}
+	if code := ExitCode(nil); code != ExitOK {
+		t.Errorf("ExitCode(nil) = %d", code)
+	}
+}
@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"github.com/MinterTeam/minter-node-cli/pb"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeCert issues a certificate for the CN signed by the parent (self-signed if parent is nil)
+// and writes it with its key to dir/name.crt and dir/name.key
+func writeCert(t *testing.T, dir, name, commonName string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+	}
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+	if err := ioutil.WriteFile(filepath.Join(dir, name+".crt"), certPEM, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, name+".key"), keyPEM, 0600); err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, key
+}
+
+func TestTLSListener(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tls")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	ca, caKey := writeCert(t, dir, "ca", "manager ca", nil, nil)
+	writeCert(t, dir, "server", "node", ca, caKey)
+	writeCert(t, dir, "ops", "ops", ca, caKey)
+	writeCert(t, dir, "stranger", "stranger", ca, caKey)
+
+	serverConfig, err := NewServerTLSConfig(filepath.Join(dir, "server.crt"), filepath.Join(dir, "server.key"), filepath.Join(dir, "ca.crt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	policy := &Policy{identities: map[string]Role{"ops": RoleReadOnly}, ownerID: -1}
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	address := lis.Addr().String()
+	_ = lis.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		err := StartCLIServer(filepath.Join(dir, "manager.sock"), &pb.UnimplementedManagerServiceServer{}, ctx,
+			WithPolicy(policy), WithTLSListener(address, serverConfig))
+		if err != nil {
+			t.Log(err)
+		}
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	for _, tt := range []struct {
+		name string
+		code codes.Code
+	}{
+		{"ops", codes.Unimplemented},
+		{"stranger", codes.PermissionDenied},
+	} {
+		clientConfig, err := NewClientTLSConfig(filepath.Join(dir, tt.name+".crt"), filepath.Join(dir, tt.name+".key"), filepath.Join(dir, "ca.crt"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		cc, err := grpc.Dial(address, grpc.WithTransportCredentials(credentials.NewTLS(clientConfig)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = pb.NewManagerServiceClient(cc).Status(context.Background(), &empty.Empty{})
+		if code := status.Code(err); code != tt.code {
+			t.Errorf("Status() as %s: code = %s, want %s (%v)", tt.name, code, tt.code, err)
+		}
+		_ = cc.Close()
+	}
+
+	clientConfig, err := NewClientTLSConfig(filepath.Join(dir, "ops.crt"), filepath.Join(dir, "ops.key"), filepath.Join(dir, "ca.crt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	console, err := ConfigureManagerConsole("", WithTLSEndpoint(address, clientConfig), WithHistory(filepath.Join(dir, "history"), DefaultHistoryLimit, DefaultSecretPatterns))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := console.Execute([]string{"status"}); status.Code(err) != codes.Unimplemented {
+		t.Errorf("status command error = %v, want %s", err, codes.Unimplemented)
+	}
+}
+
+func TestTLSListenerOptions(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tls")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	policy := &Policy{identities: map[string]Role{"ops": RoleReadOnly}, ownerID: -1}
+	for _, tt := range []struct {
+		name string
+		opts []ServerOption
+	}{
+		{"no policy", []ServerOption{WithTLSListener("127.0.0.1:0", &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert})}},
+		{"no config", []ServerOption{WithPolicy(policy), WithTLSListener("127.0.0.1:0", nil)}},
+		{"optional client certificate", []ServerOption{WithPolicy(policy), WithTLSListener("127.0.0.1:0", &tls.Config{ClientAuth: tls.VerifyClientCertIfGiven})}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := StartCLIServer(filepath.Join(dir, "manager.sock"), &pb.UnimplementedManagerServiceServer{}, context.Background(), tt.opts...)
+			if err == nil {
+				t.Error("StartCLIServer() accepted the TLS listener")
+			}
+		})
+	}
+}
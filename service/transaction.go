@@ -0,0 +1,126 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	eventsdb "github.com/MinterTeam/events-db"
+	"github.com/MinterTeam/minter-go-node/core/transaction"
+	"github.com/MinterTeam/minter-node-cli/pb"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+var txTypeNames = map[transaction.TxType]string{
+	transaction.TypeSend:                "send",
+	transaction.TypeSellCoin:            "sell_coin",
+	transaction.TypeSellAllCoin:         "sell_all_coin",
+	transaction.TypeBuyCoin:             "buy_coin",
+	transaction.TypeCreateCoin:          "create_coin",
+	transaction.TypeDeclareCandidacy:    "declare_candidacy",
+	transaction.TypeDelegate:            "delegate",
+	transaction.TypeUnbond:              "unbond",
+	transaction.TypeRedeemCheck:         "redeem_check",
+	transaction.TypeSetCandidateOnline:  "set_candidate_online",
+	transaction.TypeSetCandidateOffline: "set_candidate_offline",
+	transaction.TypeCreateMultisig:      "create_multisig",
+	transaction.TypeMultisend:           "multisend",
+	transaction.TypeEditCandidate:       "edit_candidate",
+}
+
+func txTypeName(txType transaction.TxType) string {
+	if name, ok := txTypeNames[txType]; ok {
+		return name
+	}
+	return fmt.Sprintf("0x%02X", byte(txType))
+}
+
+// decodeTransaction converts a raw Tendermint tx to a Minter transaction,
+// keeping only the hash and the raw bytes of txs which cannot be decoded
+func decodeTransaction(rawTx types.Tx) *pb.Transaction {
+	tx := &pb.Transaction{
+		Hash:  fmt.Sprintf("Mt%x", rawTx.Hash()),
+		RawTx: fmt.Sprintf("%x", []byte(rawTx)),
+	}
+
+	decoded, err := transaction.TxDecoder.DecodeFromBytes(rawTx)
+	if err != nil {
+		return tx
+	}
+	if sender, err := decoded.Sender(); err == nil {
+		tx.From = sender.String()
+	}
+	tx.Nonce = decoded.Nonce
+	tx.GasPrice = decoded.GasPrice
+	tx.Type = txTypeName(decoded.Type)
+	tx.GasCoin = decoded.GasCoin.String()
+	tx.Gas = decoded.Gas()
+	tx.Payload = decoded.Payload
+
+	return tx
+}
+
+func convertEvents(events []abci.Event) []*pb.Event {
+	result := make([]*pb.Event, 0, len(events))
+	for _, event := range events {
+		attributes := make([]*pb.Event_Attribute, 0, len(event.Attributes))
+		for _, attribute := range event.Attributes {
+			attributes = append(attributes, &pb.Event_Attribute{
+				Key:   string(attribute.Key),
+				Value: string(attribute.Value),
+			})
+		}
+		result = append(result, &pb.Event{Type: event.Type, Attributes: attributes})
+	}
+	return result
+}
+
+func convertTxResult(result *abci.ResponseDeliverTx) *pb.TxResult {
+	return &pb.TxResult{
+		Code:      result.Code,
+		Log:       result.Log,
+		Info:      result.Info,
+		GasWanted: result.GasWanted,
+		GasUsed:   result.GasUsed,
+		Events:    convertEvents(result.Events),
+		Codespace: result.Codespace,
+	}
+}
+
+// convertMinterEvent encodes a reward, slash or unbond event of the Minter events DB as JSON
+func convertMinterEvent(event eventsdb.Event) (*pb.BlockResultsResponse_MinterEvent, error) {
+	value, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	var eventType string
+	switch event.(type) {
+	case *eventsdb.RewardEvent, eventsdb.RewardEvent:
+		eventType = "reward"
+	case *eventsdb.SlashEvent, eventsdb.SlashEvent:
+		eventType = "slash"
+	case *eventsdb.UnbondEvent, eventsdb.UnbondEvent:
+		eventType = "unbond"
+	default:
+		eventType = fmt.Sprintf("%T", event)
+	}
+
+	return &pb.BlockResultsResponse_MinterEvent{Type: eventType, Value: string(value)}, nil
+}
+
+// parseTxHash accepts a transaction hash with or without the Mt prefix
+func parseTxHash(hash string) ([]byte, error) {
+	if len(hash) > 2 && strings.EqualFold(hash[:2], "Mt") {
+		hash = hash[2:]
+	}
+	decoded, err := hex.DecodeString(hash)
+	if err != nil || len(decoded) != sha256.Size {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid transaction hash: %q", hash)
+	}
+	return decoded, nil
+}
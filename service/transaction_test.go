@@ -0,0 +1,64 @@
+package service
+
+import (
+	"github.com/MinterTeam/minter-go-node/core/transaction"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+	"testing"
+)
+
+func TestDecodeTransaction(t *testing.T) {
+	tx := decodeTransaction([]byte{0x01, 0x02})
+	if tx.RawTx != "0102" || len(tx.Hash) != 66 || tx.Hash[:2] != "Mt" {
+		t.Fatalf("unexpected hash or raw tx: %+v", tx)
+	}
+	if tx.From != "" || tx.Type != "" {
+		t.Fatalf("undecodable tx has decoded fields: %+v", tx)
+	}
+
+	if name := txTypeName(transaction.TypeMultisend); name != "multisend" {
+		t.Fatalf("type name = %q", name)
+	}
+	if name := txTypeName(0x7F); name != "0x7F" {
+		t.Fatalf("unknown type name = %q", name)
+	}
+}
+
+func TestParseTxHash(t *testing.T) {
+	hash := "Mt" + strings.Repeat("ab", 32)
+	for _, input := range []string{hash, strings.ToUpper(hash), hash[2:]} {
+		decoded, err := parseTxHash(input)
+		if err != nil {
+			t.Fatalf("parseTxHash(%q): %s", input, err)
+		}
+		if len(decoded) != 32 || decoded[0] != 0xab {
+			t.Fatalf("parseTxHash(%q) = %x", input, decoded)
+		}
+	}
+	for _, input := range []string{"", "Mt", "Mtzz", "Mt" + strings.Repeat("ab", 20)} {
+		if _, err := parseTxHash(input); status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("parseTxHash(%q) error = %v", input, err)
+		}
+	}
+}
+
+func TestConvertTxResult(t *testing.T) {
+	result := convertTxResult(&abci.ResponseDeliverTx{
+		Code:    1,
+		GasUsed: 10,
+		Events: []abci.Event{{
+			Type:       "tags",
+			Attributes: []common.KVPair{{Key: []byte("tx.type"), Value: []byte("01")}},
+		}},
+	})
+	if result.Code != 1 || result.GasUsed != 10 || len(result.Events) != 1 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	attribute := result.Events[0].Attributes[0]
+	if result.Events[0].Type != "tags" || attribute.Key != "tx.type" || attribute.Value != "01" {
+		t.Fatalf("unexpected event: %+v", result.Events[0])
+	}
+}